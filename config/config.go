@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
@@ -12,8 +13,10 @@ import (
 
 type Config struct {
 	App struct {
-		Name string
-		Port string
+		Name           string
+		Port           string
+		BaseURL        string
+		TrustedProxies []string
 	}
 	Logger struct {
 		Formatter logrus.Formatter
@@ -29,10 +32,38 @@ type Config struct {
 	AES struct {
 		SecretKey string
 	}
+	Password struct {
+		Algorithm  string
+		BcryptCost int
+	}
 	BasicAuth struct {
 		Username string
 		Password string
 	}
+	Moderation struct {
+		BlockedWords    []string
+		FlaggedWords    []string
+		BlockedPattern  string
+		FlaggedPattern  string
+		FlagLinksAbove  int
+		BlockLinksAbove int
+		BlockedDomains  []string
+	}
+	Event struct {
+		LogPath string
+	}
+	Mail struct {
+		Driver       string
+		From         string
+		Dir          string
+		SMTPHost     string
+		SMTPPort     int
+		SMTPUsername string
+		SMTPPassword string
+	}
+	Notification struct {
+		BaseURL string
+	}
 	GlobalIV string
 }
 
@@ -42,8 +73,13 @@ func New() *Config {
 	c.loadMariadb()
 	c.loadRedis()
 	c.loadAes()
+	c.loadPassword()
 	c.loadBasicAuth()
 	c.loadGlobalIV()
+	c.loadModeration()
+	c.loadEvent()
+	c.loadMail()
+	c.loadNotification()
 
 	return c
 }
@@ -51,9 +87,12 @@ func New() *Config {
 func (c *Config) loadApp() *Config {
 	name := os.Getenv("APP_NAME")
 	port := os.Getenv("APP_PORT")
+	baseURL := os.Getenv("APP_BASE_URL")
 
 	c.App.Name = name
 	c.App.Port = port
+	c.App.BaseURL = baseURL
+	c.App.TrustedProxies = splitList(os.Getenv("APP_TRUSTED_PROXIES"))
 
 	return c
 }
@@ -104,6 +143,21 @@ func (c *Config) loadAes() *Config {
 	return c
 }
 
+func (c *Config) loadPassword() *Config {
+	bcryptCost, _ := strconv.ParseInt(os.Getenv("PASSWORD_BCRYPT_COST"), 10, 64)
+
+	c.Password.Algorithm = os.Getenv("PASSWORD_HASH_ALGORITHM")
+	if c.Password.Algorithm == "" {
+		c.Password.Algorithm = "argon2id"
+	}
+	c.Password.BcryptCost = int(bcryptCost)
+	if c.Password.BcryptCost == 0 {
+		c.Password.BcryptCost = 12
+	}
+
+	return c
+}
+
 func (c *Config) loadBasicAuth() *Config {
 	username := os.Getenv("BASIC_AUTH_USERNAME")
 	password := os.Getenv("BASIC_AUTH_PASSWORD")
@@ -121,3 +175,58 @@ func (c *Config) loadGlobalIV() *Config {
 
 	return c
 }
+
+func (c *Config) loadModeration() *Config {
+	flagLinksAbove, _ := strconv.ParseInt(os.Getenv("MODERATION_FLAG_LINKS_ABOVE"), 10, 64)
+	blockLinksAbove, _ := strconv.ParseInt(os.Getenv("MODERATION_BLOCK_LINKS_ABOVE"), 10, 64)
+
+	c.Moderation.BlockedWords = splitList(os.Getenv("MODERATION_BLOCKED_WORDS"))
+	c.Moderation.FlaggedWords = splitList(os.Getenv("MODERATION_FLAGGED_WORDS"))
+	c.Moderation.BlockedPattern = os.Getenv("MODERATION_BLOCKED_PATTERN")
+	c.Moderation.FlaggedPattern = os.Getenv("MODERATION_FLAGGED_PATTERN")
+	c.Moderation.FlagLinksAbove = int(flagLinksAbove)
+	c.Moderation.BlockLinksAbove = int(blockLinksAbove)
+	c.Moderation.BlockedDomains = splitList(os.Getenv("MODERATION_BLOCKED_DOMAINS"))
+
+	return c
+}
+
+func (c *Config) loadEvent() *Config {
+	c.Event.LogPath = os.Getenv("EVENT_LOG_PATH")
+
+	return c
+}
+
+func (c *Config) loadMail() *Config {
+	smtpPort, _ := strconv.ParseInt(os.Getenv("SMTP_PORT"), 10, 64)
+
+	c.Mail.Driver = os.Getenv("MAIL_DRIVER")
+	c.Mail.From = os.Getenv("MAIL_FROM")
+	c.Mail.Dir = os.Getenv("MAIL_DIR")
+	if c.Mail.Dir == "" {
+		c.Mail.Dir = "./mail"
+	}
+	c.Mail.SMTPHost = os.Getenv("SMTP_HOST")
+	c.Mail.SMTPPort = int(smtpPort)
+	c.Mail.SMTPUsername = os.Getenv("SMTP_USERNAME")
+	c.Mail.SMTPPassword = os.Getenv("SMTP_PASSWORD")
+
+	return c
+}
+
+func (c *Config) loadNotification() *Config {
+	c.Notification.BaseURL = os.Getenv("NOTIFICATION_BASE_URL")
+
+	return c
+}
+
+// splitList reads a comma separated environment value.
+func splitList(value string) (list []string) {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,185 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordAlgorithm is a type of password hashing algorithm.
+type PasswordAlgorithm string
+
+const (
+	PasswordAlgorithmArgon2id PasswordAlgorithm = "argon2id"
+	PasswordAlgorithmBcrypt   PasswordAlgorithm = "bcrypt"
+)
+
+// ErrUnknownPasswordHash is returned when an encoded password was not written by a known algorithm.
+var ErrUnknownPasswordHash = errors.New("unknown password hash")
+
+// PasswordHasher is a collection behavior of one-way password hashing.
+// The encoded hash carries the algorithm and its parameters, so stored passwords keep verifying after a change of settings.
+type PasswordHasher interface {
+	Hash(password string) (encoded string, err error)
+	Verify(password string, encoded string) (match bool, err error)
+	NeedsRehash(encoded string) bool
+}
+
+// Argon2idParams are the cost parameters of Argon2id, the memory is in KiB.
+type Argon2idParams struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idParams follow the second recommended option of RFC 9106, with a lower parallelism for small hosts.
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// DefaultBcryptCost is the cost of new bcrypt hashes.
+const DefaultBcryptCost = 12
+
+type passwordHasherImpl struct {
+	algorithm  PasswordAlgorithm
+	argon2id   Argon2idParams
+	bcryptCost int
+}
+
+// NewPasswordHasher hashes new passwords with the algorithm and verifies hashes of either algorithm.
+func NewPasswordHasher(algorithm PasswordAlgorithm, argon2id Argon2idParams, bcryptCost int) (PasswordHasher, error) {
+	if algorithm != PasswordAlgorithmArgon2id && algorithm != PasswordAlgorithmBcrypt {
+		return nil, fmt.Errorf("unsupported password hashing algorithm %q", algorithm)
+	}
+
+	return &passwordHasherImpl{
+		algorithm:  algorithm,
+		argon2id:   argon2id,
+		bcryptCost: bcryptCost,
+	}, nil
+}
+
+// Hash returns the encoded hash, `$argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>` for Argon2id.
+func (h *passwordHasherImpl) Hash(password string) (encoded string, err error) {
+	if h.algorithm == PasswordAlgorithmBcrypt {
+		hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hashed), nil
+	}
+
+	salt := make([]byte, h.argon2id.SaltLength)
+	if _, err = rand.Read(salt); err != nil {
+		return
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.argon2id.Iterations, h.argon2id.Memory, h.argon2id.Parallelism, h.argon2id.KeyLength)
+
+	encoded = fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.argon2id.Memory,
+		h.argon2id.Iterations,
+		h.argon2id.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+
+	return
+}
+
+// Verify compares the password with the encoded hash in constant time.
+func (h *passwordHasherImpl) Verify(password string, encoded string) (match bool, err error) {
+	if isBcrypt(encoded) {
+		err = bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(computed, key) == 1, nil
+}
+
+// NeedsRehash reports whether the hash was written with another algorithm or other cost parameters.
+func (h *passwordHasherImpl) NeedsRehash(encoded string) bool {
+	if isBcrypt(encoded) {
+		if h.algorithm != PasswordAlgorithmBcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(encoded))
+		return err != nil || cost != h.bcryptCost
+	}
+
+	if h.algorithm != PasswordAlgorithmArgon2id {
+		return true
+	}
+
+	params, _, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.argon2id.Memory ||
+		params.Iterations != h.argon2id.Iterations ||
+		params.Parallelism != h.argon2id.Parallelism ||
+		uint32(len(key)) != h.argon2id.KeyLength
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+func decodeArgon2id(encoded string) (params Argon2idParams, salt []byte, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != string(PasswordAlgorithmArgon2id) {
+		err = ErrUnknownPasswordHash
+		return
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		err = ErrUnknownPasswordHash
+		return
+	}
+
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		err = ErrUnknownPasswordHash
+		return
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		err = ErrUnknownPasswordHash
+		return
+	}
+
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		err = ErrUnknownPasswordHash
+		return
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return
+}
@@ -0,0 +1,79 @@
+package crypto_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/sangianpatrick/devoria-article-service/crypto"
+)
+
+var testArgon2idParams = crypto.Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestPasswordHasherArgon2id(t *testing.T) {
+	hasher, err := crypto.NewPasswordHasher(crypto.PasswordAlgorithmArgon2id, testArgon2idParams, bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	encoded, err := hasher.Hash("P@ssw0rdTest")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	other, _ := hasher.Hash("P@ssw0rdTest")
+	assert.NotEqual(t, encoded, other, "salts should differ")
+
+	match, err := hasher.Verify("P@ssw0rdTest", encoded)
+	assert.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = hasher.Verify("wrong", encoded)
+	assert.NoError(t, err)
+	assert.False(t, match)
+
+	assert.False(t, hasher.NeedsRehash(encoded))
+
+	stronger := testArgon2idParams
+	stronger.Iterations = 2
+	strongerHasher, _ := crypto.NewPasswordHasher(crypto.PasswordAlgorithmArgon2id, stronger, bcrypt.MinCost)
+	assert.True(t, strongerHasher.NeedsRehash(encoded))
+}
+
+func TestPasswordHasherBcrypt(t *testing.T) {
+	bcryptHasher, _ := crypto.NewPasswordHasher(crypto.PasswordAlgorithmBcrypt, testArgon2idParams, bcrypt.MinCost)
+	argon2idHasher, _ := crypto.NewPasswordHasher(crypto.PasswordAlgorithmArgon2id, testArgon2idParams, bcrypt.MinCost)
+
+	encoded, err := bcryptHasher.Hash("P@ssw0rdTest")
+	assert.NoError(t, err)
+
+	match, err := argon2idHasher.Verify("P@ssw0rdTest", encoded)
+	assert.NoError(t, err)
+	assert.True(t, match, "bcrypt hashes should verify whatever the configured algorithm")
+
+	match, err = bcryptHasher.Verify("wrong", encoded)
+	assert.NoError(t, err)
+	assert.False(t, match)
+
+	assert.False(t, bcryptHasher.NeedsRehash(encoded))
+	assert.True(t, argon2idHasher.NeedsRehash(encoded))
+}
+
+func TestPasswordHasherVerify_Unknown(t *testing.T) {
+	hasher, _ := crypto.NewPasswordHasher(crypto.PasswordAlgorithmArgon2id, testArgon2idParams, bcrypt.MinCost)
+
+	// AES ciphertext written before passwords were hashed
+	match, err := hasher.Verify("P@ssw0rdTest", "9f86d081884c7d659a2feaa0c55ad015")
+	assert.Equal(t, crypto.ErrUnknownPasswordHash, err)
+	assert.False(t, match)
+}
+
+func TestNewPasswordHasher_Unsupported(t *testing.T) {
+	_, err := crypto.NewPasswordHasher("md5", testArgon2idParams, bcrypt.MinCost)
+	assert.Error(t, err)
+}
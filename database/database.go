@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+// Conn is what the repositories run their statements on, the database or a transaction.
+type Conn interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type txKey struct{}
+
+// WithTx carries the transaction in the context, the repositories called with it join the transaction.
+func WithTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext is the transaction carried by the context, nil when there is none.
+func TxFromContext(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(txKey{}).(*sql.Tx)
+	return tx
+}
+
+// InTransaction tells whether the statements run with the context are part of a transaction.
+func InTransaction(ctx context.Context) bool {
+	return TxFromContext(ctx) != nil
+}
+
+// Connection is the transaction of the context, or the database when there is none.
+func Connection(ctx context.Context, db *sql.DB) Conn {
+	if tx := TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return db
+}
+
+// Tx is a transaction begun by a repository. When the context already carries one it is joined,
+// committing and rolling back are then left to whoever began it.
+type Tx struct {
+	*sql.Tx
+	joined bool
+}
+
+// Begin starts a transaction, or joins the one of the context.
+func Begin(ctx context.Context, db *sql.DB) (tx *Tx, err error) {
+	if joined := TxFromContext(ctx); joined != nil {
+		return &Tx{Tx: joined, joined: true}, nil
+	}
+
+	begun, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return
+	}
+
+	return &Tx{Tx: begun}, nil
+}
+
+func (tx *Tx) Commit() error {
+	if tx.joined {
+		return nil
+	}
+
+	return tx.Tx.Commit()
+}
+
+func (tx *Tx) Rollback() error {
+	if tx.joined {
+		return nil
+	}
+
+	return tx.Tx.Rollback()
+}
+
+// Transactor runs a unit of work in a single transaction.
+type Transactor interface {
+	// Within commits what fn wrote when it returns no error and rolls it back otherwise.
+	Within(ctx context.Context, fn func(ctx context.Context) error) (err error)
+}
+
+type transactorImpl struct {
+	db *sql.DB
+}
+
+func NewTransactor(db *sql.DB) Transactor {
+	return &transactorImpl{
+		db: db,
+	}
+}
+
+func (t *transactorImpl) Within(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, err := Begin(ctx, t.db)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer tx.Rollback()
+
+	err = fn(WithTx(ctx, tx.Tx))
+	if err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
+	return
+}
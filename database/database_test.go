@@ -0,0 +1,64 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+func TestTransactorWithin_JoinedByRepositories(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	//One transaction for the whole unit of work, the nested one commits nothing itself
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE article").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := database.NewTransactor(db).Within(context.TODO(), func(ctx context.Context) (err error) {
+		assert.True(t, database.InTransaction(ctx))
+
+		tx, err := database.Begin(ctx, db)
+		if err != nil {
+			return
+		}
+		defer tx.Rollback()
+
+		if _, err = tx.ExecContext(ctx, "UPDATE article SET title = ?", "New"); err != nil {
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			return
+		}
+
+		_, err = database.Connection(ctx, db).ExecContext(ctx, "INSERT INTO audit_log (action) VALUES (?)", "article.edit")
+		return
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransactorWithin_RollsBackOnError(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE article").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	err := database.NewTransactor(db).Within(context.TODO(), func(ctx context.Context) (err error) {
+		if _, err = database.Connection(ctx, db).ExecContext(ctx, "UPDATE article SET title = ?", "New"); err != nil {
+			return
+		}
+
+		return exception.ErrInternalServer
+	})
+
+	assert.Equal(t, exception.ErrInternalServer, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,26 +1,56 @@
 package entity
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 )
 
-const AccountSessionKeyFormat = "account:session:%s"
+// AccountSessionKeyFormat is the key of the sessions of an account, kept as a hash. Every device signed in
+// holds a token carrying one of its fields as the `jti` claim, mapped to the unix time the token expires.
+const AccountSessionKeyFormat = "account:sessions:%s"
 
 type AccountContextKey string
 
-const EmailCtx AccountContextKey = "email"
+// AccountRole is a type of account privilege.
+type AccountRole string
+
+const (
+	AccountRoleAuthor AccountRole = "AUTHOR"
+	AccountRoleEditor AccountRole = "EDITOR"
+	AccountRoleAdmin  AccountRole = "ADMIN"
+)
+
+const (
+	EmailCtx     AccountContextKey = "email"
+	SessionIDCtx AccountContextKey = "sessionId"
+)
 
 // Account is a collection of proprty of account.
 type Account struct {
-	ID             int64      `json:"id"`
-	Email          string     `json:"email"`
-	Password       *string    `json:"password,omitempty"`
-	FirstName      string     `json:"firstName"`
-	LastName       string     `json:"lastName"`
-	CreatedAt      time.Time  `json:"createdAt"`
-	LastModifiedAt *time.Time `json:"lastModifiedAt"`
+	ID             int64       `json:"id"`
+	Email          string      `json:"email"`
+	Password       *string     `json:"password,omitempty"`
+	FirstName      string      `json:"firstName"`
+	LastName       string      `json:"lastName"`
+	Role           AccountRole `json:"role"`
+	Handle         string      `json:"handle,omitempty"`
+	AvatarURL      string      `json:"avatarUrl,omitempty"`
+	Mentionable    bool        `json:"mentionable"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	LastModifiedAt *time.Time  `json:"lastModifiedAt"`
+}
+
+// HasRole reports whether the account has one of the given roles.
+func (a Account) HasRole(roles ...AccountRole) bool {
+	for _, role := range roles {
+		if a.Role == role {
+			return true
+		}
+	}
+
+	return false
 }
 
 // CustomerStandardJWTClaims is a model.
@@ -28,3 +58,14 @@ type AccountStandardJWTClaims struct {
 	jwt.StandardClaims
 	Email string `json:"email"`
 }
+
+// FormatSessionExpiry is how the expiry of a session is kept in the session store.
+func FormatSessionExpiry(expiresAt int64) []byte {
+	return []byte(strconv.FormatInt(expiresAt, 10))
+}
+
+// SessionActive reports whether a session kept with the given expiry has not expired yet.
+func SessionActive(expiry []byte, now time.Time) bool {
+	expiresAt, err := strconv.ParseInt(string(expiry), 10, 64)
+	return err == nil && now.Unix() < expiresAt
+}
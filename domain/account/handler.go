@@ -2,7 +2,9 @@ package account
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
@@ -29,7 +31,11 @@ func NewAccountHTTPHandler(
 
 	router.HandleFunc("/v1/accounts/registration", basicAuthMiddleware.Verify(handler.Register)).Methods(http.MethodPost)
 	router.HandleFunc("/v1/accounts/login", basicAuthMiddleware.Verify(handler.Login)).Methods(http.MethodPost)
+	router.HandleFunc("/v1/accounts/logout", bearerAuthMiddleware.VerifyBearer(handler.Logout)).Methods(http.MethodPost)
 	router.HandleFunc("/v1/accounts/profile", bearerAuthMiddleware.VerifyBearer(handler.GetProfile)).Methods(http.MethodGet)
+	router.HandleFunc("/v1/accounts/{id:[0-9]+}", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, handler.GetPublicProfile)).Methods(http.MethodGet)
+	router.HandleFunc("/v1/accounts/mention-preference", bearerAuthMiddleware.VerifyBearer(handler.UpdateMentionPreference)).Methods(http.MethodPut)
+	router.HandleFunc("/v1/accounts/handle", bearerAuthMiddleware.VerifyBearer(handler.UpdateHandle)).Methods(http.MethodPut)
 
 }
 
@@ -79,9 +85,99 @@ func (handler *AccountHTTPHandler) Login(w http.ResponseWriter, r *http.Request)
 	resp.JSON(w)
 }
 
+func (handler *AccountHTTPHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params LogoutRequest
+	var ctx = r.Context()
+
+	// Signing out of the current device needs no body.
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil && err != io.EOF {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Logout(ctx, params)
+	resp.JSON(w)
+}
+
 func (handler *AccountHTTPHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	var resp response.Response
 	var ctx = r.Context()
-	resp = handler.Usecase.GetProfile(ctx)
+	resp = response.WithFields(handler.Usecase.GetProfile(ctx), response.RequestedFields(r))
+	resp.JSON(w)
+}
+
+func (handler *AccountHTTPHandler) GetPublicProfile(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetPublicProfileRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetPublicProfile(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *AccountHTTPHandler) UpdateMentionPreference(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UpdateMentionPreferenceRequest
+	var ctx = r.Context()
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.UpdateMentionPreference(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *AccountHTTPHandler) UpdateHandle(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UpdateHandleRequest
+	var ctx = r.Context()
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.UpdateHandle(ctx, params)
 	resp.JSON(w)
 }
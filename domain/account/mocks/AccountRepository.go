@@ -36,6 +36,29 @@ func (_m *AccountRepository) FindByEmail(ctx context.Context, email string) (ent
 	return r0, r1
 }
 
+// FindByHandles provides a mock function with given fields: ctx, handles
+func (_m *AccountRepository) FindByHandles(ctx context.Context, handles []string) ([]entity.Account, error) {
+	ret := _m.Called(ctx, handles)
+
+	var r0 []entity.Account
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []entity.Account); ok {
+		r0 = rf(ctx, handles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Account)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, handles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByID provides a mock function with given fields: ctx, ID
 func (_m *AccountRepository) FindByID(ctx context.Context, ID int64) (entity.Account, error) {
 	ret := _m.Called(ctx, ID)
@@ -57,6 +80,29 @@ func (_m *AccountRepository) FindByID(ctx context.Context, ID int64) (entity.Acc
 	return r0, r1
 }
 
+// FindByIDs provides a mock function with given fields: ctx, IDs
+func (_m *AccountRepository) FindByIDs(ctx context.Context, IDs []int64) ([]entity.Account, error) {
+	ret := _m.Called(ctx, IDs)
+
+	var r0 []entity.Account
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []entity.Account); ok {
+		r0 = rf(ctx, IDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Account)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, IDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *AccountRepository) Save(ctx context.Context, _a1 entity.Account) (int64, error) {
 	ret := _m.Called(ctx, _a1)
@@ -91,3 +137,45 @@ func (_m *AccountRepository) Update(ctx context.Context, ID int64, updatedAccoun
 
 	return r0
 }
+
+// UpdateHandle provides a mock function with given fields: ctx, ID, handle
+func (_m *AccountRepository) UpdateHandle(ctx context.Context, ID int64, handle string) error {
+	ret := _m.Called(ctx, ID, handle)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, ID, handle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMentionable provides a mock function with given fields: ctx, ID, mentionable
+func (_m *AccountRepository) UpdateMentionable(ctx context.Context, ID int64, mentionable bool) error {
+	ret := _m.Called(ctx, ID, mentionable)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = rf(ctx, ID, mentionable)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, ID, password
+func (_m *AccountRepository) UpdatePassword(ctx context.Context, ID int64, password string) error {
+	ret := _m.Called(ctx, ID, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, ID, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
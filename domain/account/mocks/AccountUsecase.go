@@ -33,6 +33,22 @@ func (_m *AccountUsecase) GetProfile(ctx context.Context) response.Response {
 	return r0
 }
 
+// GetPublicProfile provides a mock function with given fields: ctx, params
+func (_m *AccountUsecase) GetPublicProfile(ctx context.Context, params account.GetPublicProfileRequest) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, account.GetPublicProfileRequest) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
 // Login provides a mock function with given fields: ctx, params
 func (_m *AccountUsecase) Login(ctx context.Context, params account.AccountAuthenticationRequest) response.Response {
 	ret := _m.Called(ctx, params)
@@ -49,6 +65,22 @@ func (_m *AccountUsecase) Login(ctx context.Context, params account.AccountAuthe
 	return r0
 }
 
+// Logout provides a mock function with given fields: ctx, params
+func (_m *AccountUsecase) Logout(ctx context.Context, params account.LogoutRequest) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, account.LogoutRequest) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields: ctx, params
 func (_m *AccountUsecase) Register(ctx context.Context, params account.AccountRegistrationRequest) response.Response {
 	ret := _m.Called(ctx, params)
@@ -64,3 +96,35 @@ func (_m *AccountUsecase) Register(ctx context.Context, params account.AccountRe
 
 	return r0
 }
+
+// UpdateHandle provides a mock function with given fields: ctx, params
+func (_m *AccountUsecase) UpdateHandle(ctx context.Context, params account.UpdateHandleRequest) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, account.UpdateHandleRequest) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// UpdateMentionPreference provides a mock function with given fields: ctx, params
+func (_m *AccountUsecase) UpdateMentionPreference(ctx context.Context, params account.UpdateMentionPreferenceRequest) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, account.UpdateMentionPreferenceRequest) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
@@ -5,8 +5,12 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/go-sql-driver/mysql"
+	"github.com/sangianpatrick/devoria-article-service/database"
 	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/event"
 	"github.com/sangianpatrick/devoria-article-service/exception"
 )
 
@@ -15,23 +19,46 @@ type AccountRepository interface {
 	Update(ctx context.Context, ID int64, updatedAccount entity.Account) (err error)
 	FindByEmail(ctx context.Context, email string) (account entity.Account, err error)
 	FindByID(ctx context.Context, ID int64) (account entity.Account, err error)
+	FindByIDs(ctx context.Context, IDs []int64) (accounts []entity.Account, err error)
+	FindByHandles(ctx context.Context, handles []string) (accounts []entity.Account, err error)
+	UpdateMentionable(ctx context.Context, ID int64, mentionable bool) (err error)
+	UpdatePassword(ctx context.Context, ID int64, password string) (err error)
+	UpdateHandle(ctx context.Context, ID int64, handle string) (err error)
 }
 
 type accountRepositoryImpl struct {
 	db        *sql.DB
 	tableName string
+	outbox    event.Outbox
 }
 
-func NewAccountRepository(db *sql.DB, tableName string) AccountRepository {
+// NewAccountRepository writes the account events to the outbox in the same transaction as the change.
+func NewAccountRepository(db *sql.DB, tableName string, outbox event.Outbox) AccountRepository {
 	return &accountRepositoryImpl{
 		db:        db,
 		tableName: tableName,
+		outbox:    outbox,
 	}
 }
 
+// AccountRegisteredEvent is the payload of the account registered event.
+type AccountRegisteredEvent struct {
+	ID        int64  `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
 func (r *accountRepositoryImpl) Save(ctx context.Context, account entity.Account) (ID int64, err error) {
+	tx, err := database.Begin(ctx, r.db)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer tx.Rollback()
+
 	command := fmt.Sprintf("INSERT INTO %s (email, password, firstName, lastName, createdAt) VALUES (?, ?, ?, ?, ?)", r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, command)
+	stmt, err := tx.PrepareContext(ctx, command)
 	if err != nil {
 		log.Println(err)
 		return
@@ -54,12 +81,34 @@ func (r *accountRepositoryImpl) Save(ctx context.Context, account entity.Account
 
 	ID, _ = result.LastInsertId()
 
+	payload := AccountRegisteredEvent{
+		ID:        ID,
+		Email:     account.Email,
+		FirstName: account.FirstName,
+		LastName:  account.LastName,
+	}
+	evt, err := event.New(event.AccountRegistered, ID, payload, account.CreatedAt)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	err = r.outbox.Add(ctx, tx.Tx, evt)
+	if err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+	}
+
 	return
 }
 
 func (r *accountRepositoryImpl) Update(ctx context.Context, ID int64, updatedAccount entity.Account) (err error) {
 	command := fmt.Sprintf(`UPDATE %s SET password = ?, firstName = ?, lastName = ?, lastModified = ? WHERE id = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, command)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -91,8 +140,8 @@ func (r *accountRepositoryImpl) Update(ctx context.Context, ID int64, updatedAcc
 }
 
 func (r *accountRepositoryImpl) FindByEmail(ctx context.Context, email string) (account entity.Account, err error) {
-	query := fmt.Sprintf(`SELECT id, email, password, firstName, lastName, createdAt, lastModified FROM %s WHERE email = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, query)
+	query := fmt.Sprintf(`SELECT id, email, password, firstName, lastName, role, handle, mentionable, createdAt, lastModified FROM %s WHERE email = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
 		return
@@ -102,6 +151,7 @@ func (r *accountRepositoryImpl) FindByEmail(ctx context.Context, email string) (
 	row := stmt.QueryRowContext(ctx, email)
 
 	var password sql.NullString
+	var handle sql.NullString
 	var lastModifiedAt sql.NullTime
 
 	err = row.Scan(
@@ -110,6 +160,9 @@ func (r *accountRepositoryImpl) FindByEmail(ctx context.Context, email string) (
 		&password,
 		&account.FirstName,
 		&account.LastName,
+		&account.Role,
+		&handle,
+		&account.Mentionable,
 		&account.CreatedAt,
 		&lastModifiedAt,
 	)
@@ -124,6 +177,8 @@ func (r *accountRepositoryImpl) FindByEmail(ctx context.Context, email string) (
 		account.Password = &password.String
 	}
 
+	account.Handle = handle.String
+
 	if lastModifiedAt.Valid {
 		account.LastModifiedAt = &lastModifiedAt.Time
 	}
@@ -132,8 +187,8 @@ func (r *accountRepositoryImpl) FindByEmail(ctx context.Context, email string) (
 }
 
 func (r *accountRepositoryImpl) FindByID(ctx context.Context, ID int64) (account entity.Account, err error) {
-	query := fmt.Sprintf(`SELECT id, email, password, firstName, lastName, createdAt, lastModified FROM %s WHERE id = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, query)
+	query := fmt.Sprintf(`SELECT id, email, password, firstName, lastName, role, mentionable, createdAt, lastModified FROM %s WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -152,6 +207,8 @@ func (r *accountRepositoryImpl) FindByID(ctx context.Context, ID int64) (account
 		&password,
 		&account.FirstName,
 		&account.LastName,
+		&account.Role,
+		&account.Mentionable,
 		&account.CreatedAt,
 		&lastModifiedAt,
 	)
@@ -172,3 +229,193 @@ func (r *accountRepositoryImpl) FindByID(ctx context.Context, ID int64) (account
 
 	return
 }
+
+// FindByIDs loads the public profile of many accounts in a single query, missing accounts are simply left out.
+func (r *accountRepositoryImpl) FindByIDs(ctx context.Context, IDs []int64) (accounts []entity.Account, err error) {
+	if len(IDs) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(IDs)), ", ")
+	query := fmt.Sprintf(`SELECT id, firstName, lastName, handle, avatarUrl FROM %s WHERE id IN (%s)`, r.tableName, placeholders)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	args := make([]interface{}, len(IDs))
+	for i, ID := range IDs {
+		args[i] = ID
+	}
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		account := entity.Account{}
+		var handle sql.NullString
+		var avatarURL sql.NullString
+
+		err = rows.Scan(
+			&account.ID,
+			&account.FirstName,
+			&account.LastName,
+			&handle,
+			&avatarURL,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		account.Handle = handle.String
+		account.AvatarURL = avatarURL.String
+
+		accounts = append(accounts, account)
+	}
+
+	return
+}
+
+// FindByHandles loads the accounts that can be mentioned by the given handles.
+// Accounts that opted out of mentions are left out, as if the handle did not exist.
+func (r *accountRepositoryImpl) FindByHandles(ctx context.Context, handles []string) (accounts []entity.Account, err error) {
+	if len(handles) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(handles)), ", ")
+	query := fmt.Sprintf(`SELECT id, email, firstName, lastName, handle, avatarUrl FROM %s WHERE handle IN (%s) AND mentionable = 1`, r.tableName, placeholders)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	args := make([]interface{}, len(handles))
+	for i, handle := range handles {
+		args[i] = handle
+	}
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		account := entity.Account{}
+		var avatarURL sql.NullString
+
+		err = rows.Scan(
+			&account.ID,
+			&account.Email,
+			&account.FirstName,
+			&account.LastName,
+			&account.Handle,
+			&avatarURL,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		account.AvatarURL = avatarURL.String
+		account.Mentionable = true
+
+		accounts = append(accounts, account)
+	}
+
+	return
+}
+
+func (r *accountRepositoryImpl) UpdateMentionable(ctx context.Context, ID int64, mentionable bool) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET mentionable = ? WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, mentionable, ID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
+	return
+}
+
+// UpdatePassword replaces the stored password hash without touching the rest of the account.
+func (r *accountRepositoryImpl) UpdatePassword(ctx context.Context, ID int64, password string) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET password = ? WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, password, ID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+// mysqlDuplicateEntry is the error number of a unique key violation.
+const mysqlDuplicateEntry = 1062
+
+// UpdateHandle sets the handle the account is mentioned by, a handle taken by another account is a conflict.
+func (r *accountRepositoryImpl) UpdateHandle(ctx context.Context, ID int64, handle string) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET handle = ? WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, handle, ID)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == mysqlDuplicateEntry {
+			err = exception.ErrConflicted
+			return
+		}
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
+	return
+}
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/sangianpatrick/devoria-article-service/domain/account"
 	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	"github.com/sangianpatrick/devoria-article-service/exception"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,12 +45,18 @@ func TestRepositorySave_Success(t *testing.T) {
 		newAccount.CreatedAt,
 	)
 
+	mock.ExpectBegin()
 	mock.ExpectPrepare(expectedCommand).
 		ExpectExec().
 		WithArgs(expectedArgs...).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectPrepare("INSERT INTO event_outbox").
+		ExpectExec().
+		WithArgs("account.registered", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-	accountRepository := account.NewAccountRepository(db, tableName)
+	accountRepository := account.NewAccountRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
 	ID, err := accountRepository.Save(ctx, newAccount)
 
 	assert.NoError(t, err, "should not be error")
@@ -70,6 +79,9 @@ func TestRepositoryFindByEmail_Success(t *testing.T) {
 		sqlmock.NewColumn("password"),
 		sqlmock.NewColumn("firstName"),
 		sqlmock.NewColumn("lastName"),
+		sqlmock.NewColumn("role"),
+		sqlmock.NewColumn("handle"),
+		sqlmock.NewColumn("mentionable"),
 		sqlmock.NewColumn("createdAt"),
 		sqlmock.NewColumn("lastModified"),
 	).AddRow(
@@ -78,11 +90,14 @@ func TestRepositoryFindByEmail_Success(t *testing.T) {
 		&expectedAccountPassowrd,
 		"John",
 		"Doe",
+		"AUTHOR",
+		"johndoe",
+		true,
 		time.Now(),
 		nil,
 	)
 
-	expectedQuery := fmt.Sprintf(`SELECT id, email, password, firstName, lastName, createdAt, lastModified FROM %s WHERE email = ?`, tableName)
+	expectedQuery := fmt.Sprintf(`SELECT id, email, password, firstName, lastName, role, handle, mentionable, createdAt, lastModified FROM %s WHERE email = ?`, tableName)
 	expectedArgs := make([]driver.Value, 0)
 	expectedArgs = append(expectedArgs, expectedAccountEmail)
 
@@ -90,14 +105,126 @@ func TestRepositoryFindByEmail_Success(t *testing.T) {
 		WithArgs(expectedArgs...).
 		WillReturnRows(expectedRows)
 
-	accountRepository := account.NewAccountRepository(db, tableName)
+	accountRepository := account.NewAccountRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
 	existingAccount, err := accountRepository.FindByEmail(ctx, expectedAccountEmail)
 
 	assert.NoError(t, err, "should not be error")
 	assert.Equal(t, expectedAccountEmail, existingAccount.Email, fmt.Sprintf("email should be '%s'", expectedAccountEmail))
+	assert.Equal(t, "johndoe", existingAccount.Handle)
 	assert.Nil(t, existingAccount.LastModifiedAt, "last modified at should be nil")
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Error(err)
 	}
 }
+
+func TestRepositoryFindByIDs_Success(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	ctx := context.TODO()
+	expectedRows := sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("id"),
+		sqlmock.NewColumn("firstName"),
+		sqlmock.NewColumn("lastName"),
+		sqlmock.NewColumn("handle"),
+		sqlmock.NewColumn("avatarUrl"),
+	).AddRow(
+		int64(1),
+		"John",
+		"Doe",
+		"johndoe",
+		nil,
+	).AddRow(
+		int64(2),
+		"Jane",
+		"Doe",
+		nil,
+		"https://cdn.devoria.id/avatar/2.png",
+	)
+
+	expectedQuery := fmt.Sprintf(`SELECT id, firstName, lastName, handle, avatarUrl FROM %s WHERE id IN \(\?, \?\)`, tableName)
+
+	mock.ExpectPrepare(expectedQuery).ExpectQuery().
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(expectedRows)
+
+	accountRepository := account.NewAccountRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	accounts, err := accountRepository.FindByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, err, "should not be error")
+	assert.Len(t, accounts, 2)
+	assert.Equal(t, "johndoe", accounts[0].Handle)
+	assert.Equal(t, "https://cdn.devoria.id/avatar/2.png", accounts[1].AvatarURL)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryFindByIDs_Empty(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	accountRepository := account.NewAccountRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	accounts, err := accountRepository.FindByIDs(context.TODO(), nil)
+
+	assert.NoError(t, err, "should not be error")
+	assert.Empty(t, accounts)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryFindByHandles_Success(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	ctx := context.TODO()
+	expectedRows := sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("id"),
+		sqlmock.NewColumn("email"),
+		sqlmock.NewColumn("firstName"),
+		sqlmock.NewColumn("lastName"),
+		sqlmock.NewColumn("handle"),
+		sqlmock.NewColumn("avatarUrl"),
+	).AddRow(
+		int64(1),
+		"john.doe@email.com",
+		"John",
+		"Doe",
+		"johndoe",
+		nil,
+	)
+
+	expectedQuery := fmt.Sprintf(`SELECT id, email, firstName, lastName, handle, avatarUrl FROM %s WHERE handle IN \(\?, \?\) AND mentionable = 1`, tableName)
+
+	mock.ExpectPrepare(expectedQuery).ExpectQuery().
+		WithArgs("johndoe", "ghost").
+		WillReturnRows(expectedRows)
+
+	accountRepository := account.NewAccountRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	accounts, err := accountRepository.FindByHandles(ctx, []string{"johndoe", "ghost"})
+
+	assert.NoError(t, err, "should not be error")
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, "john.doe@email.com", accounts[0].Email)
+	assert.True(t, accounts[0].Mentionable)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryUpdateHandle_Taken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	mock.ExpectPrepare(fmt.Sprintf("UPDATE %s SET handle", tableName)).
+		ExpectExec().
+		WithArgs("johndoe", int64(1)).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'johndoe' for key 'handle'"})
+
+	accountRepository := account.NewAccountRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	err := accountRepository.UpdateHandle(context.TODO(), 1, "johndoe")
+
+	assert.Equal(t, exception.ErrConflicted, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
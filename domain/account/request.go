@@ -13,3 +13,23 @@ type AccountAuthenticationRequest struct {
 	Email    string `json:"email" validate:"required"`
 	Password string `json:"password" validate:"required"`
 }
+
+// GetPublicProfileRequest is a model for finding the public profile of an account.
+type GetPublicProfileRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+// UpdateMentionPreferenceRequest is a model for opting in or out of being mentioned in articles.
+type UpdateMentionPreferenceRequest struct {
+	Mentionable *bool `json:"mentionable" validate:"required"`
+}
+
+// UpdateHandleRequest is a model for choosing the handle an account is mentioned by.
+type UpdateHandleRequest struct {
+	Handle string `json:"handle" validate:"required,max=50"`
+}
+
+// LogoutRequest is a model for signing out of the current device or of every device.
+type LogoutRequest struct {
+	AllDevices bool `json:"allDevices"`
+}
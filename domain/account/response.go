@@ -6,3 +6,21 @@ type AccountAuthenticationResponse struct {
 	Token   string  `json:"token"`
 	Profile entity.Account `json:"profile"`
 }
+
+// PublicProfileResponse is what anyone may see of an account.
+type PublicProfileResponse struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Handle    string `json:"handle,omitempty"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+}
+
+type MentionPreferenceResponse struct {
+	Mentionable bool `json:"mentionable"`
+}
+
+// HandleResponse is the handle an account is mentioned by.
+type HandleResponse struct {
+	Handle string `json:"handle"`
+}
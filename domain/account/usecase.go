@@ -3,9 +3,11 @@ package account
 import (
 	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
+	"log"
+	"regexp"
 	"time"
 
 	"github.com/sangianpatrick/devoria-article-service/crypto"
@@ -19,16 +21,27 @@ import (
 type AccountUsecase interface {
 	Register(ctx context.Context, params AccountRegistrationRequest) (resp response.Response)
 	Login(ctx context.Context, params AccountAuthenticationRequest) (resp response.Response)
+	Logout(ctx context.Context, params LogoutRequest) (resp response.Response)
 	GetProfile(ctx context.Context) (resp response.Response)
+	GetPublicProfile(ctx context.Context, params GetPublicProfileRequest) (resp response.Response)
+	UpdateMentionPreference(ctx context.Context, params UpdateMentionPreferenceRequest) (resp response.Response)
+	UpdateHandle(ctx context.Context, params UpdateHandleRequest) (resp response.Response)
 }
 
+// handlePattern is what an @mention in an article can refer to.
+var handlePattern = regexp.MustCompile(`^[A-Za-z0-9_]{1,50}$`)
+
+// sessionMaxAge is how long a token is valid, the session store keeps sessions as long.
+const sessionMaxAge = time.Hour * 24 * 1
+
 type accountUsecaseImpl struct {
-	globalIV     string
-	session      session.Session
-	jsonWebToken jwt.JSONWebToken
-	crypto       crypto.Crypto
-	location     *time.Location
-	repository   AccountRepository
+	globalIV       string
+	session        session.Session
+	jsonWebToken   jwt.JSONWebToken
+	crypto         crypto.Crypto
+	passwordHasher crypto.PasswordHasher
+	location       *time.Location
+	repository     AccountRepository
 }
 
 func NewAccountUsecase(
@@ -36,16 +49,18 @@ func NewAccountUsecase(
 	session session.Session,
 	jsonWebToken jwt.JSONWebToken,
 	crypto crypto.Crypto,
+	passwordHasher crypto.PasswordHasher,
 	location *time.Location,
 	repository AccountRepository,
 ) AccountUsecase {
 	return &accountUsecaseImpl{
-		globalIV:     globalIV,
-		session:      session,
-		jsonWebToken: jsonWebToken,
-		crypto:       crypto,
-		location:     location,
-		repository:   repository,
+		globalIV:       globalIV,
+		session:        session,
+		jsonWebToken:   jsonWebToken,
+		crypto:         crypto,
+		passwordHasher: passwordHasher,
+		location:       location,
+		repository:     repository,
 	}
 }
 
@@ -74,12 +89,17 @@ func (u *accountUsecaseImpl) Register(ctx context.Context, params AccountRegistr
 	if err != exception.ErrNotFound {
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
-	encryptedPassword := u.crypto.Encrypt(params.Password, u.globalIV)
+	hashedPassword, err := u.passwordHasher.Hash(params.Password)
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
 	newAccount := entity.Account{}
 	newAccount.Email = params.Email
-	newAccount.Password = &encryptedPassword
+	newAccount.Password = &hashedPassword
 	newAccount.FirstName = params.FirstName
 	newAccount.LastName = params.LastName
+	newAccount.Mentionable = true
 	newAccount.CreatedAt = time.Now().In(u.location)
 
 	ID, err := u.repository.Save(ctx, newAccount)
@@ -88,28 +108,13 @@ func (u *accountUsecaseImpl) Register(ctx context.Context, params AccountRegistr
 	}
 	newAccount.ID = ID
 
-	claims := entity.AccountStandardJWTClaims{}
-	claims.Email = newAccount.Email
-	claims.Subject = fmt.Sprintf("%d", newAccount.ID)
-	claims.IssuedAt = time.Now().Unix()
-	claims.ExpiresAt = time.Now().Add(time.Hour * 24 * 1).Unix()
-
-	token, err := u.jsonWebToken.Sign(ctx, claims)
-	if err != nil {
-		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
-	}
-
-	newAccountBuff, _ := json.Marshal(newAccount)
+	newAccount.Password = nil
 
-	err = u.session.Set(ctx, fmt.Sprintf(entity.AccountSessionKeyFormat, newAccount.Email), newAccountBuff)
+	token, err := u.startSession(ctx, newAccount)
 	if err != nil {
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	// publish to kafke if availabe
-
-	newAccount.Password = nil
-
 	accountAuthenticationResponse := AccountAuthenticationResponse{}
 	accountAuthenticationResponse.Token = token
 	accountAuthenticationResponse.Profile = newAccount
@@ -126,39 +131,140 @@ func (u *accountUsecaseImpl) Login(ctx context.Context, params AccountAuthentica
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	encryptedPassword := u.crypto.Encrypt(params.Password, u.globalIV)
-	if encryptedPassword != *account.Password {
+	match, legacy, err := u.verifyPassword(params.Password, *account.Password)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+	if !match {
 		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
 	}
 
+	// The password is only known right after a successful sign in, it is the one chance to upgrade the stored hash.
+	if legacy || u.passwordHasher.NeedsRehash(*account.Password) {
+		u.rehashPassword(ctx, &account, params.Password)
+	}
+
+	account.Password = nil
+
+	token, err := u.startSession(ctx, account)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	accountAuthenticationResponse := AccountAuthenticationResponse{}
+	accountAuthenticationResponse.Token = token
+	accountAuthenticationResponse.Profile = account
+
+	return response.Success(response.StatusOK, accountAuthenticationResponse)
+}
+
+// verifyPassword compares the password with the stored hash in constant time.
+// Rows written before passwords were hashed hold AES ciphertext, they are reported as legacy to be upgraded.
+func (u *accountUsecaseImpl) verifyPassword(password string, stored string) (match bool, legacy bool, err error) {
+	match, err = u.passwordHasher.Verify(password, stored)
+	if err != crypto.ErrUnknownPasswordHash {
+		if err != nil {
+			log.Println(err)
+		}
+		return
+	}
+
+	encryptedPassword := u.crypto.Encrypt(password, u.globalIV)
+	match = subtle.ConstantTimeCompare([]byte(encryptedPassword), []byte(stored)) == 1
+
+	return match, true, nil
+}
+
+// rehashPassword stores the password with the current hashing settings, a failure leaves the old hash working.
+func (u *accountUsecaseImpl) rehashPassword(ctx context.Context, account *entity.Account, password string) {
+	hashedPassword, err := u.passwordHasher.Hash(password)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if err = u.repository.UpdatePassword(ctx, account.ID, hashedPassword); err != nil {
+		return
+	}
+
+	account.Password = &hashedPassword
+}
+
+// startSession signs a token for a new device of the account and adds its session ID to the sessions of the account.
+// The store keeps the sessions as long as a token lives, so each sign in extends them to cover the newest token.
+func (u *accountUsecaseImpl) startSession(ctx context.Context, account entity.Account) (token string, err error) {
+	sessionID := u.generateBase64String(16)
+	if sessionID == "" {
+		return "", exception.ErrInternalServer
+	}
+
+	now := time.Now()
+
 	claims := entity.AccountStandardJWTClaims{}
+	claims.Id = sessionID
 	claims.Email = account.Email
 	claims.Subject = fmt.Sprintf("%d", account.ID)
-	claims.IssuedAt = time.Now().Unix()
-	claims.ExpiresAt = time.Now().Add(time.Hour * 24 * 1).Unix()
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(sessionMaxAge).Unix()
 
-	token, err := u.jsonWebToken.Sign(ctx, claims)
+	token, err = u.jsonWebToken.Sign(ctx, claims)
 	if err != nil {
-		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		return
 	}
 
-	accountBuff, _ := json.Marshal(account)
+	key := fmt.Sprintf(entity.AccountSessionKeyFormat, account.Email)
 
-	err = u.session.Set(ctx, fmt.Sprintf(entity.AccountSessionKeyFormat, account.Email), accountBuff)
+	err = u.session.SetField(ctx, key, sessionID, entity.FormatSessionExpiry(claims.ExpiresAt))
 	if err != nil {
-		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		return
 	}
 
-	// publish to kafke if availabe
+	u.dropExpiredSessions(ctx, key, now)
 
-	account.Password = nil
+	return
+}
 
-	accountAuthenticationResponse := AccountAuthenticationResponse{}
-	accountAuthenticationResponse.Token = token
-	accountAuthenticationResponse.Profile = account
+// dropExpiredSessions deletes the session IDs whose token has expired, the sessions of an account
+// that signs in every day would otherwise never expire as a whole.
+func (u *accountUsecaseImpl) dropExpiredSessions(ctx context.Context, key string, now time.Time) {
+	sessions, err := u.session.GetFields(ctx, key)
+	if err != nil {
+		return
+	}
 
-	return response.Success(response.StatusOK, accountAuthenticationResponse)
+	expired := make([]string, 0)
+	for sessionID, expiry := range sessions {
+		if !entity.SessionActive(expiry, now) {
+			expired = append(expired, sessionID)
+		}
+	}
+
+	if len(expired) > 0 {
+		u.session.DeleteFields(ctx, key, expired...)
+	}
 }
+
+// Logout ends the session of the token the request was signed in with, or of every device of the account.
+func (u *accountUsecaseImpl) Logout(ctx context.Context, params LogoutRequest) (resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	key := fmt.Sprintf(entity.AccountSessionKeyFormat, email)
+
+	var err error
+	if params.AllDevices {
+		err = u.session.Delete(ctx, key)
+	} else {
+		sessionID, _ := ctx.Value(entity.SessionIDCtx).(string)
+		err = u.session.DeleteFields(ctx, key, sessionID)
+	}
+
+	//Another request may have ended the sessions already
+	if err != nil && err != session.ErrSessionNotFound {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
 func (u *accountUsecaseImpl) GetProfile(ctx context.Context) (resp response.Response) {
 	email := ctx.Value(entity.EmailCtx).(string)
 	account, err := u.repository.FindByEmail(ctx, email)
@@ -171,3 +277,69 @@ func (u *accountUsecaseImpl) GetProfile(ctx context.Context) (resp response.Resp
 
 	return response.Success(response.StatusOK, account)
 }
+
+// GetPublicProfile is the profile readers land on from author bylines and mentions.
+func (u *accountUsecaseImpl) GetPublicProfile(ctx context.Context, params GetPublicProfileRequest) (resp response.Response) {
+	accounts, err := u.repository.FindByIDs(ctx, []int64{params.ID})
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if len(accounts) == 0 {
+		return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	publicProfileResponse := PublicProfileResponse{}
+	publicProfileResponse.ID = accounts[0].ID
+	publicProfileResponse.FirstName = accounts[0].FirstName
+	publicProfileResponse.LastName = accounts[0].LastName
+	publicProfileResponse.Handle = accounts[0].Handle
+	publicProfileResponse.AvatarURL = accounts[0].AvatarURL
+
+	return response.Success(response.StatusOK, publicProfileResponse)
+}
+
+// UpdateMentionPreference lets the account opt out of being mentioned, mentions of it are then left as plain text.
+func (u *accountUsecaseImpl) UpdateMentionPreference(ctx context.Context, params UpdateMentionPreferenceRequest) (resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.repository.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	err = u.repository.UpdateMentionable(ctx, account.ID, *params.Mentionable)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, MentionPreferenceResponse{Mentionable: *params.Mentionable})
+}
+
+// UpdateHandle sets the handle other authors mention the account by.
+func (u *accountUsecaseImpl) UpdateHandle(ctx context.Context, params UpdateHandleRequest) (resp response.Response) {
+	if !handlePattern.MatchString(params.Handle) {
+		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+	}
+
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.repository.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	err = u.repository.UpdateHandle(ctx, account.ID, params.Handle)
+	if err != nil {
+		if err == exception.ErrConflicted {
+			return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, HandleResponse{Handle: params.Handle})
+}
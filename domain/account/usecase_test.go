@@ -5,12 +5,15 @@ import (
 	"testing"
 	"time"
 
+	cryptoPackage "github.com/sangianpatrick/devoria-article-service/crypto"
 	cryptoMocks "github.com/sangianpatrick/devoria-article-service/crypto/mocks"
 	"github.com/sangianpatrick/devoria-article-service/domain/account"
 	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
 	"github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
 	"github.com/sangianpatrick/devoria-article-service/exception"
 	jsonWebTokenMocks "github.com/sangianpatrick/devoria-article-service/jwt/mocks"
+	"github.com/sangianpatrick/devoria-article-service/response"
+	"github.com/sangianpatrick/devoria-article-service/session"
 	sessionMocks "github.com/sangianpatrick/devoria-article-service/session/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,23 +31,29 @@ func TestMain(m *testing.M) {
 
 func TestUsecaseRegister_Success(t *testing.T) {
 	sess := new(sessionMocks.Session)
-	sess.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(nil)
+	sess.On("SetField", mock.Anything, "account:sessions:john.doe@email.com", mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(nil)
+	sess.On("GetFields", mock.Anything, "account:sessions:john.doe@email.com").Return(map[string][]byte{}, nil)
 
 	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
 	jsonWebToken.On("Sign", mock.Anything, mock.AnythingOfType("entity.AccountStandardJWTClaims")).Return("mock token", nil)
 
 	crypto := new(cryptoMocks.Crypto)
-	crypto.On("Encrypt", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("encrypted password")
+
+	passwordHasher := new(cryptoMocks.PasswordHasher)
+	passwordHasher.On("Hash", "P@ssw0rdTest").Return("$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5", nil)
 
 	accountRepository := new(mocks.AccountRepository)
 	accountRepository.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{}, exception.ErrNotFound)
-	accountRepository.On("Save", mock.Anything, mock.AnythingOfType("entity.Account")).Return(int64(1), nil)
+	accountRepository.On("Save", mock.Anything, mock.MatchedBy(func(account entity.Account) bool {
+		return *account.Password == "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5"
+	})).Return(int64(1), nil)
 
 	accountUsecase := account.NewAccountUsecase(
 		"globalIVTest",
 		sess,
 		jsonWebToken,
 		crypto,
+		passwordHasher,
 		location,
 		accountRepository,
 	)
@@ -63,5 +72,164 @@ func TestUsecaseRegister_Success(t *testing.T) {
 	sess.AssertExpectations(t)
 	jsonWebToken.AssertExpectations(t)
 	crypto.AssertExpectations(t)
+	passwordHasher.AssertExpectations(t)
+	accountRepository.AssertExpectations(t)
+}
+
+func TestUsecaseLogin_UpgradesLegacyPassword(t *testing.T) {
+	sess := new(sessionMocks.Session)
+	sess.On("SetField", mock.Anything, "account:sessions:john.doe@email.com", mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Return(nil)
+	sess.On("GetFields", mock.Anything, "account:sessions:john.doe@email.com").Return(map[string][]byte{}, nil)
+
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	jsonWebToken.On("Sign", mock.Anything, mock.AnythingOfType("entity.AccountStandardJWTClaims")).Return("mock token", nil)
+
+	crypto := new(cryptoMocks.Crypto)
+	crypto.On("Encrypt", "P@ssw0rdTest", "globalIVTest").Return("a1b2c3")
+
+	passwordHasher := new(cryptoMocks.PasswordHasher)
+	passwordHasher.On("Verify", "P@ssw0rdTest", "a1b2c3").Return(false, cryptoPackage.ErrUnknownPasswordHash)
+	passwordHasher.On("Hash", "P@ssw0rdTest").Return("$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5", nil)
+
+	legacyPassword := "a1b2c3"
+	accountRepository := new(mocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com", Password: &legacyPassword}, nil)
+	accountRepository.On("UpdatePassword", mock.Anything, int64(1), "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5").Return(nil)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", sess, jsonWebToken, crypto, passwordHasher, location, accountRepository)
+
+	resp := accountUsecase.Login(context.TODO(), account.AccountAuthenticationRequest{Email: "john.doe@email.com", Password: "P@ssw0rdTest"})
+
+	assert.NoError(t, resp.Err())
+
+	crypto.AssertExpectations(t)
+	passwordHasher.AssertExpectations(t)
 	accountRepository.AssertExpectations(t)
 }
+
+func TestUsecaseLogin_WrongPassword(t *testing.T) {
+	storedPassword := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5"
+
+	passwordHasher := new(cryptoMocks.PasswordHasher)
+	passwordHasher.On("Verify", "wrong", storedPassword).Return(false, nil)
+
+	accountRepository := new(mocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com", Password: &storedPassword}, nil)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), passwordHasher, location, accountRepository)
+
+	resp := accountUsecase.Login(context.TODO(), account.AccountAuthenticationRequest{Email: "john.doe@email.com", Password: "wrong"})
+
+	assert.Equal(t, exception.ErrBadRequest, resp.Err())
+
+	passwordHasher.AssertExpectations(t)
+	accountRepository.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecaseLogin_DropsExpiredSessions(t *testing.T) {
+	storedPassword := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5"
+
+	var signedClaims entity.AccountStandardJWTClaims
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	jsonWebToken.On("Sign", mock.Anything, mock.AnythingOfType("entity.AccountStandardJWTClaims")).Run(func(args mock.Arguments) {
+		signedClaims = args.Get(1).(entity.AccountStandardJWTClaims)
+	}).Return("mock token", nil)
+
+	var storedSessionID string
+	var storedExpiry []byte
+	sess := new(sessionMocks.Session)
+	sess.On("SetField", mock.Anything, "account:sessions:john.doe@email.com", mock.AnythingOfType("string"), mock.AnythingOfType("[]uint8")).Run(func(args mock.Arguments) {
+		storedSessionID = args.Get(2).(string)
+		storedExpiry = args.Get(3).([]byte)
+	}).Return(nil)
+	sess.On("GetFields", mock.Anything, "account:sessions:john.doe@email.com").Return(map[string][]byte{
+		"other-device": entity.FormatSessionExpiry(time.Now().Add(time.Hour).Unix()),
+		"expired":      entity.FormatSessionExpiry(time.Now().Add(-time.Hour).Unix()),
+	}, nil)
+	sess.On("DeleteFields", mock.Anything, "account:sessions:john.doe@email.com", "expired").Return(nil)
+
+	passwordHasher := new(cryptoMocks.PasswordHasher)
+	passwordHasher.On("Verify", "P@ssw0rdTest", storedPassword).Return(true, nil)
+	passwordHasher.On("NeedsRehash", storedPassword).Return(false)
+
+	accountRepository := new(mocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com", Password: &storedPassword}, nil)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", sess, jsonWebToken, new(cryptoMocks.Crypto), passwordHasher, location, accountRepository)
+
+	resp := accountUsecase.Login(context.TODO(), account.AccountAuthenticationRequest{Email: "john.doe@email.com", Password: "P@ssw0rdTest"})
+
+	assert.NoError(t, resp.Err())
+	assert.NotEmpty(t, signedClaims.Id, "the token should carry a session ID")
+	assert.Equal(t, signedClaims.Id, storedSessionID)
+	assert.Equal(t, entity.FormatSessionExpiry(signedClaims.ExpiresAt), storedExpiry)
+
+	sess.AssertExpectations(t)
+	sess.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func signedInContext(sessionID string) context.Context {
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+	return context.WithValue(ctx, entity.SessionIDCtx, sessionID)
+}
+
+func TestUsecaseLogout_CurrentDevice(t *testing.T) {
+	sess := new(sessionMocks.Session)
+	sess.On("DeleteFields", mock.Anything, "account:sessions:john.doe@email.com", "this-device").Return(nil)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", sess, new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), new(cryptoMocks.PasswordHasher), location, new(mocks.AccountRepository))
+
+	resp := accountUsecase.Logout(signedInContext("this-device"), account.LogoutRequest{})
+
+	assert.NoError(t, resp.Err())
+
+	sess.AssertExpectations(t)
+	sess.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestUsecaseLogout_AllDevices(t *testing.T) {
+	sess := new(sessionMocks.Session)
+	sess.On("Delete", mock.Anything, "account:sessions:john.doe@email.com").Return(nil)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", sess, new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), new(cryptoMocks.PasswordHasher), location, new(mocks.AccountRepository))
+
+	resp := accountUsecase.Logout(signedInContext("this-device"), account.LogoutRequest{AllDevices: true})
+
+	assert.NoError(t, resp.Err())
+
+	sess.AssertExpectations(t)
+	sess.AssertNotCalled(t, "DeleteFields", mock.Anything, mock.Anything)
+}
+
+func TestUsecaseLogout_AlreadyLoggedOut(t *testing.T) {
+	sess := new(sessionMocks.Session)
+	sess.On("Delete", mock.Anything, "account:sessions:john.doe@email.com").Return(session.ErrSessionNotFound)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", sess, new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), new(cryptoMocks.PasswordHasher), location, new(mocks.AccountRepository))
+
+	resp := accountUsecase.Logout(signedInContext("this-device"), account.LogoutRequest{AllDevices: true})
+
+	assert.NoError(t, resp.Err())
+}
+
+func TestUsecaseUpdateHandle(t *testing.T) {
+	accountRepository := new(mocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com"}, nil)
+	accountRepository.On("UpdateHandle", mock.Anything, int64(1), "john_doe").Return(nil)
+	accountRepository.On("UpdateHandle", mock.Anything, int64(1), "janedoe").Return(exception.ErrConflicted)
+
+	accountUsecase := account.NewAccountUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), new(cryptoMocks.PasswordHasher), location, accountRepository)
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+
+	resp := accountUsecase.UpdateHandle(ctx, account.UpdateHandleRequest{Handle: "john_doe"})
+	assert.NoError(t, resp.Err())
+	assert.Equal(t, account.HandleResponse{Handle: "john_doe"}, response.Data(resp))
+
+	resp = accountUsecase.UpdateHandle(ctx, account.UpdateHandleRequest{Handle: "janedoe"})
+	assert.Equal(t, exception.ErrConflicted, resp.Err(), "a taken handle should conflict")
+
+	resp = accountUsecase.UpdateHandle(ctx, account.UpdateHandleRequest{Handle: "john.doe"})
+	assert.Equal(t, exception.ErrBadRequest, resp.Err(), "a handle a mention cannot refer to should be rejected")
+
+	accountRepository.AssertNumberOfCalls(t, "UpdateHandle", 2)
+}
@@ -0,0 +1,42 @@
+package article
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+)
+
+// ArticleExcerptLength is the number of characters anonymous readers see of a members article.
+const ArticleExcerptLength = 280
+
+// isAuthenticated reports whether the request came with a bearer token.
+func isAuthenticated(ctx context.Context) bool {
+	email, ok := ctx.Value(entity.EmailCtx).(string)
+	return ok && email != ""
+}
+
+// excerpt cuts the content at the last whole word within the excerpt length.
+func excerpt(content string) string {
+	if utf8.RuneCountInString(content) <= ArticleExcerptLength {
+		return content
+	}
+
+	cut := string([]rune(content)[:ArticleExcerptLength])
+	if i := strings.LastIndexAny(cut, " \n\t"); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimSpace(cut) + "…"
+}
+
+// restrict replaces the content of a members article with its excerpt for anonymous readers.
+func restrict(ctx context.Context, article *Article) {
+	if article.AccessLevel != ArticleAccessLevelMembers || isAuthenticated(ctx) {
+		return
+	}
+
+	article.Content = excerpt(article.Content)
+	article.Locked = true
+}
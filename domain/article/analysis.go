@@ -0,0 +1,459 @@
+package article
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Thresholds of the writing-quality warnings.
+const (
+	AnalysisLongSentenceWords   = 25
+	AnalysisPassiveRatioWarning = 0.2
+	AnalysisAdverbRatioWarning  = 0.05
+	AnalysisRepeatedWordMin     = 4
+	AnalysisRepeatedWordRatio   = 0.02
+	AnalysisDifficultScore      = 30
+)
+
+// indonesianSyllableOffset is how many more syllables an Indonesian word has on average than an English one.
+// The Flesch formula was fitted on English, removing the difference keeps Indonesian scores on the same scale.
+const indonesianSyllableOffset = 1.1
+
+// sentenceLengthBuckets are the upper bounds, in words, of the sentence length distribution.
+var sentenceLengthBuckets = []int{10, 20, 30, 40}
+
+var (
+	sentenceEndPattern = regexp.MustCompile(`[.!?]+["')\]]*(\s+|$)|\n\s*\n`)
+	wordPattern        = regexp.MustCompile(`[\pL\pN]+(?:['’-][\pL\pN]+)*`)
+)
+
+var englishBeVerbs = map[string]bool{
+	"am": true, "is": true, "are": true, "was": true, "were": true, "be": true, "been": true, "being": true,
+}
+
+// englishIrregularParticiples are the common past participles that do not end in -ed.
+var englishIrregularParticiples = map[string]bool{
+	"born": true, "built": true, "bought": true, "brought": true, "caught": true, "chosen": true, "done": true,
+	"drawn": true, "driven": true, "eaten": true, "fallen": true, "felt": true, "found": true, "forgotten": true,
+	"given": true, "gone": true, "grown": true, "heard": true, "held": true, "hidden": true, "kept": true,
+	"known": true, "laid": true, "led": true, "left": true, "lost": true, "made": true, "meant": true,
+	"met": true, "paid": true, "put": true, "read": true, "run": true, "said": true, "seen": true, "sent": true,
+	"set": true, "shown": true, "shut": true, "sold": true, "spent": true, "spoken": true, "stolen": true,
+	"taken": true, "taught": true, "thought": true, "told": true, "understood": true, "won": true, "written": true,
+}
+
+// englishNonAdverbs end in -ly without being adverbs.
+var englishNonAdverbs = map[string]bool{
+	"ally": true, "apply": true, "belly": true, "bully": true, "family": true, "fly": true, "holy": true,
+	"italy": true, "july": true, "lily": true, "reply": true, "rely": true, "silly": true, "supply": true,
+	"ugly": true, "only": true, "early": true, "friendly": true, "lonely": true, "lovely": true, "likely": true,
+}
+
+// indonesianAdverbs are the intensifiers and hedges editors usually cut.
+var indonesianAdverbs = map[string]bool{
+	"sangat": true, "sekali": true, "amat": true, "terlalu": true, "agak": true, "cukup": true,
+	"sungguh": true, "benar-benar": true, "sangat-sangat": true, "begitu": true, "cuma": true,
+	"hanya": true, "mungkin": true, "sering": true, "selalu": true, "tentunya": true, "sebenarnya": true,
+}
+
+// indonesianNonPassives start with `di` without being passive verbs.
+var indonesianNonPassives = map[string]bool{
+	"dia": true, "diam": true, "dian": true, "didik": true, "dilema": true, "dingin": true, "dinding": true,
+	"dini": true, "dinas": true, "dinamis": true, "dinamika": true, "diri": true, "dirinya": true, "disiplin": true,
+	"diskon": true, "diskusi": true, "divisi": true, "diet": true, "digital": true, "dimensi": true, "diploma": true,
+	"direktur": true, "direksi": true, "dialog": true, "diagram": true, "diagnosis": true, "dimana": true,
+}
+
+var stopWords = map[string]bool{
+	// English
+	"about": true, "after": true, "also": true, "because": true, "been": true, "before": true, "being": true,
+	"could": true, "does": true, "each": true, "from": true, "have": true, "into": true, "just": true, "more": true,
+	"most": true, "much": true, "only": true, "other": true, "over": true, "same": true, "should": true,
+	"some": true, "such": true, "than": true, "that": true, "their": true, "them": true, "then": true, "there": true,
+	"these": true, "they": true, "this": true, "those": true, "very": true, "were": true, "what": true,
+	"when": true, "where": true, "which": true, "while": true, "will": true, "with": true, "would": true,
+	"your": true,
+	// Indonesian
+	"adalah": true, "agar": true, "akan": true, "atau": true, "bagi": true, "bahwa": true, "balik": true,
+	"banyak": true, "belum": true, "bisa": true, "dalam": true, "dapat": true, "dari": true, "dengan": true,
+	"harus": true, "hingga": true, "itu": true, "jika": true, "juga": true, "kami": true, "kita": true,
+	"karena": true, "kepada": true, "ketika": true, "lebih": true, "masih": true, "mereka": true,
+	"oleh": true, "pada": true, "para": true, "saat": true, "sama": true, "saja": true, "sangat": true,
+	"sebagai": true, "secara": true, "sedang": true, "sejak": true, "seperti": true, "sudah": true,
+	"tapi": true, "telah": true, "tetapi": true, "untuk": true, "yang": true,
+}
+
+// ReadabilityScore is a Flesch reading ease score, higher is easier.
+type ReadabilityScore struct {
+	Score float64 `json:"score"`
+	Level string  `json:"level"`
+}
+
+// ReadabilityScores are the scores of the content read as Indonesian and as English.
+type ReadabilityScores struct {
+	Indonesian ReadabilityScore `json:"id"`
+	English    ReadabilityScore `json:"en"`
+}
+
+// SentenceLengthBucket counts the sentences whose word count is up to the bound, the last bucket has no bound.
+type SentenceLengthBucket struct {
+	MinWords int  `json:"minWords"`
+	MaxWords *int `json:"maxWords"`
+	Count    int  `json:"count"`
+}
+
+// SentenceLengths is the distribution of the number of words per sentence.
+type SentenceLengths struct {
+	Min     int                    `json:"min"`
+	Max     int                    `json:"max"`
+	Average float64                `json:"average"`
+	Median  float64                `json:"median"`
+	Buckets []SentenceLengthBucket `json:"buckets"`
+}
+
+// PassiveVoice lists the sentences that look passive.
+type PassiveVoice struct {
+	Count     int      `json:"count"`
+	Ratio     float64  `json:"ratio"`
+	Sentences []string `json:"sentences"`
+}
+
+// Adverbs counts the adverbs found in the content.
+type Adverbs struct {
+	Count int            `json:"count"`
+	Ratio float64        `json:"ratio"`
+	Words map[string]int `json:"words"`
+}
+
+// RepeatedWord is a word used often enough to be worth a synonym.
+type RepeatedWord struct {
+	Word  string `json:"word"`
+	Count int    `json:"count"`
+}
+
+// ContentAnalysis is the writing feedback of an article content.
+// Passive voice and adverbs are heuristics in the language of the article, Indonesian unless it is English.
+type ContentAnalysis struct {
+	Language        string            `json:"language"`
+	Sentences       int               `json:"sentences"`
+	Words           int               `json:"words"`
+	Readability     ReadabilityScores `json:"readability"`
+	SentenceLengths SentenceLengths   `json:"sentenceLengths"`
+	PassiveVoice    PassiveVoice      `json:"passiveVoice"`
+	Adverbs         Adverbs           `json:"adverbs"`
+	RepeatedWords   []RepeatedWord    `json:"repeatedWords"`
+	Warnings        []string          `json:"warnings"`
+}
+
+// AnalyzeContent scores the readability of the content and looks for the usual writing issues.
+func AnalyzeContent(content string, language string) (analysis ContentAnalysis) {
+	language = baseLanguage(normalizeLanguage(language))
+	if language != "en" {
+		language = ArticleDefaultLanguage
+	}
+
+	analysis.Language = language
+	analysis.RepeatedWords = []RepeatedWord{}
+	analysis.Warnings = []string{}
+	analysis.PassiveVoice.Sentences = []string{}
+	analysis.Adverbs.Words = map[string]int{}
+
+	sentences := splitSentences(content)
+	lengths := make([]int, 0, len(sentences))
+	var words []string
+	var englishSyllables, indonesianSyllables int
+
+	for i, sentence := range sentences {
+		sentenceWords := splitWords(sentence)
+		lengths = append(lengths, len(sentenceWords))
+		words = append(words, sentenceWords...)
+
+		for _, word := range sentenceWords {
+			englishSyllables += englishSyllableCount(word)
+			indonesianSyllables += indonesianSyllableCount(word)
+		}
+
+		if len(sentenceWords) > AnalysisLongSentenceWords {
+			analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("sentence %d has %d words, consider splitting it", i+1, len(sentenceWords)))
+		}
+
+		if isPassive(sentenceWords, language) {
+			analysis.PassiveVoice.Sentences = append(analysis.PassiveVoice.Sentences, sentence)
+		}
+
+		for j := 1; j < len(sentenceWords); j++ {
+			if sentenceWords[j] == sentenceWords[j-1] && !strings.ContainsAny(sentenceWords[j], "0123456789") {
+				analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("sentence %d repeats %q twice in a row", i+1, sentenceWords[j]))
+			}
+		}
+	}
+
+	analysis.Sentences = len(sentences)
+	analysis.Words = len(words)
+	if analysis.Words == 0 {
+		analysis.SentenceLengths.Buckets = sentenceLengthDistribution(lengths)
+		return
+	}
+
+	wordsPerSentence := float64(analysis.Words) / float64(analysis.Sentences)
+	analysis.Readability.English = fleschReadingEase(wordsPerSentence, float64(englishSyllables)/float64(analysis.Words))
+	analysis.Readability.Indonesian = fleschReadingEase(wordsPerSentence, float64(indonesianSyllables)/float64(analysis.Words)-indonesianSyllableOffset)
+
+	analysis.SentenceLengths = sentenceLengths(lengths)
+
+	analysis.PassiveVoice.Count = len(analysis.PassiveVoice.Sentences)
+	analysis.PassiveVoice.Ratio = round(float64(analysis.PassiveVoice.Count) / float64(analysis.Sentences))
+
+	counts := make(map[string]int)
+	for _, word := range words {
+		counts[word]++
+		if isAdverb(word, language) {
+			analysis.Adverbs.Words[word]++
+			analysis.Adverbs.Count++
+		}
+	}
+	analysis.Adverbs.Ratio = round(float64(analysis.Adverbs.Count) / float64(analysis.Words))
+
+	for word, count := range counts {
+		if len([]rune(word)) < 4 || stopWords[word] || count < AnalysisRepeatedWordMin || float64(count)/float64(analysis.Words) < AnalysisRepeatedWordRatio {
+			continue
+		}
+		analysis.RepeatedWords = append(analysis.RepeatedWords, RepeatedWord{Word: word, Count: count})
+	}
+	sort.Slice(analysis.RepeatedWords, func(i, j int) bool {
+		if analysis.RepeatedWords[i].Count != analysis.RepeatedWords[j].Count {
+			return analysis.RepeatedWords[i].Count > analysis.RepeatedWords[j].Count
+		}
+		return analysis.RepeatedWords[i].Word < analysis.RepeatedWords[j].Word
+	})
+
+	for _, repeated := range analysis.RepeatedWords {
+		analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("%q is used %d times, consider a synonym", repeated.Word, repeated.Count))
+	}
+	if analysis.PassiveVoice.Ratio > AnalysisPassiveRatioWarning {
+		analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("%.0f%% of the sentences are passive", analysis.PassiveVoice.Ratio*100))
+	}
+	if analysis.Adverbs.Ratio > AnalysisAdverbRatioWarning {
+		analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("%.0f%% of the words are adverbs", analysis.Adverbs.Ratio*100))
+	}
+
+	score := analysis.Readability.Indonesian
+	if language == "en" {
+		score = analysis.Readability.English
+	}
+	if score.Score < AnalysisDifficultScore {
+		analysis.Warnings = append(analysis.Warnings, "the content is difficult to read, prefer shorter sentences and words")
+	}
+
+	return
+}
+
+func splitSentences(content string) (sentences []string) {
+	start := 0
+	for _, loc := range sentenceEndPattern.FindAllStringIndex(content, -1) {
+		if sentence := strings.TrimSpace(content[start:loc[1]]); wordPattern.MatchString(sentence) {
+			sentences = append(sentences, sentence)
+		}
+		start = loc[1]
+	}
+
+	if sentence := strings.TrimSpace(content[start:]); wordPattern.MatchString(sentence) {
+		sentences = append(sentences, sentence)
+	}
+
+	return
+}
+
+func splitWords(sentence string) []string {
+	words := wordPattern.FindAllString(strings.ToLower(sentence), -1)
+	for i, word := range words {
+		words[i] = strings.ReplaceAll(word, "’", "'")
+	}
+
+	return words
+}
+
+// fleschReadingEase is 206.835 - 1.015 × words per sentence - 84.6 × syllables per word,
+// kept within 0 and 100 as the formula overshoots on text far from the language it was fitted on.
+func fleschReadingEase(wordsPerSentence, syllablesPerWord float64) ReadabilityScore {
+	score := round(math.Max(0, math.Min(100, 206.835-1.015*wordsPerSentence-84.6*syllablesPerWord)))
+
+	var level string
+	switch {
+	case score >= 90:
+		level = "very easy"
+	case score >= 80:
+		level = "easy"
+	case score >= 70:
+		level = "fairly easy"
+	case score >= 60:
+		level = "standard"
+	case score >= 50:
+		level = "fairly difficult"
+	case score >= 30:
+		level = "difficult"
+	default:
+		level = "very difficult"
+	}
+
+	return ReadabilityScore{Score: score, Level: level}
+}
+
+// englishSyllableCount counts the vowel groups, a silent final `e` does not make a syllable.
+func englishSyllableCount(word string) (count int) {
+	if !hasLetter(word) {
+		return 1
+	}
+
+	previousVowel := false
+	for _, r := range word {
+		vowel := strings.ContainsRune("aeiouy", r)
+		if vowel && !previousVowel {
+			count++
+		}
+		previousVowel = vowel
+	}
+
+	if strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") && !strings.HasSuffix(word, "ee") && count > 1 {
+		count--
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	return
+}
+
+// indonesianSyllableCount counts every vowel as a syllable except for the diphthongs ai, au, ei and oi.
+func indonesianSyllableCount(word string) (count int) {
+	if !hasLetter(word) {
+		return 1
+	}
+
+	runes := []rune(word)
+	for i := 0; i < len(runes); i++ {
+		if !strings.ContainsRune("aeiou", runes[i]) {
+			continue
+		}
+		count++
+		// A diphthong only counts once when it closes the syllable, as in `pantai` or `kerbau`.
+		if i+1 < len(runes) && isIndonesianDiphthong(runes[i], runes[i+1]) && (i+2 == len(runes) || !strings.ContainsRune("aeiou", runes[i+2])) {
+			i++
+		}
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	return
+}
+
+func isIndonesianDiphthong(first, second rune) bool {
+	switch string([]rune{first, second}) {
+	case "ai", "au", "ei", "oi":
+		return true
+	}
+
+	return false
+}
+
+func hasLetter(word string) bool {
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPassive looks for a form of `to be` followed by a past participle in English,
+// and for a `di-` verb in Indonesian.
+func isPassive(words []string, language string) bool {
+	for i, word := range words {
+		if language == "en" {
+			if !englishBeVerbs[word] {
+				continue
+			}
+			for _, next := range words[i+1 : minInt(i+3, len(words))] {
+				if (strings.HasSuffix(next, "ed") && len(next) > 3) || englishIrregularParticiples[next] {
+					return true
+				}
+			}
+			continue
+		}
+
+		if strings.HasPrefix(word, "di") && len([]rune(word)) > 4 && !indonesianNonPassives[word] && !strings.ContainsAny(word, "0123456789") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isAdverb(word string, language string) bool {
+	if language == "en" {
+		return strings.HasSuffix(word, "ly") && len(word) > 4 && !englishNonAdverbs[word]
+	}
+
+	return indonesianAdverbs[word]
+}
+
+func sentenceLengths(lengths []int) (distribution SentenceLengths) {
+	sorted := append([]int(nil), lengths...)
+	sort.Ints(sorted)
+
+	total := 0
+	for _, length := range sorted {
+		total += length
+	}
+
+	distribution.Min = sorted[0]
+	distribution.Max = sorted[len(sorted)-1]
+	distribution.Average = round(float64(total) / float64(len(sorted)))
+	if middle := len(sorted) / 2; len(sorted)%2 == 0 {
+		distribution.Median = float64(sorted[middle-1]+sorted[middle]) / 2
+	} else {
+		distribution.Median = float64(sorted[middle])
+	}
+	distribution.Buckets = sentenceLengthDistribution(lengths)
+
+	return
+}
+
+func sentenceLengthDistribution(lengths []int) (buckets []SentenceLengthBucket) {
+	minWords := 1
+	for i := range sentenceLengthBuckets {
+		bound := sentenceLengthBuckets[i]
+		buckets = append(buckets, SentenceLengthBucket{MinWords: minWords, MaxWords: &bound})
+		minWords = bound + 1
+	}
+	buckets = append(buckets, SentenceLengthBucket{MinWords: minWords})
+
+	for _, length := range lengths {
+		for i := range buckets {
+			if buckets[i].MaxWords == nil || length <= *buckets[i].MaxWords {
+				buckets[i].Count++
+				break
+			}
+		}
+	}
+
+	return
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
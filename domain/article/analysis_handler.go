@@ -0,0 +1,58 @@
+package article
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type AnalysisHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  AnalysisUsecase
+}
+
+func NewAnalysisHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase AnalysisUsecase,
+) {
+	handler := &AnalysisHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Post
+	router.HandleFunc("/v1/article/{id:[0-9]+}/analyze", bearerAuthMiddleware.VerifyBearer(handler.Analyze)).Methods(http.MethodPost)
+}
+
+func (handler *AnalysisHTTPHandler) Analyze(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params AnalyzeArticleRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Analyze(ctx, params)
+	resp.JSON(w)
+}
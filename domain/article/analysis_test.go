@@ -0,0 +1,67 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+func TestAnalyzeContent_English(t *testing.T) {
+	content := "The cat sat on the mat. The report was written by the team quickly. It is really very simple to read. The the dog barked loudly."
+
+	analysis := article.AnalyzeContent(content, "en-US")
+
+	assert.Equal(t, "en", analysis.Language)
+	assert.Equal(t, 4, analysis.Sentences)
+	assert.Equal(t, 26, analysis.Words)
+	assert.Greater(t, analysis.Readability.English.Score, 80.0)
+	assert.Equal(t, []string{"The report was written by the team quickly."}, analysis.PassiveVoice.Sentences)
+	assert.Equal(t, map[string]int{"quickly": 1, "really": 1, "loudly": 1}, analysis.Adverbs.Words)
+	assert.Contains(t, analysis.Warnings, `sentence 4 repeats "the" twice in a row`)
+	assert.Equal(t, 4, analysis.SentenceLengths.Buckets[0].Count)
+}
+
+func TestAnalyzeContent_Indonesian(t *testing.T) {
+	content := "Artikel ini ditulis oleh tim redaksi. Kami sangat senang membaca tulisan yang sederhana. Dia duduk di pantai bersama kerbau. Tulisan itu dibaca oleh banyak orang. Tulisan yang baik membuat tulisan lain terasa mudah, karena tulisan itu jelas."
+
+	analysis := article.AnalyzeContent(content, "")
+
+	assert.Equal(t, "id", analysis.Language)
+	assert.Equal(t, 5, analysis.Sentences)
+	assert.Equal(t, []string{"Artikel ini ditulis oleh tim redaksi.", "Tulisan itu dibaca oleh banyak orang."}, analysis.PassiveVoice.Sentences)
+	assert.Equal(t, map[string]int{"sangat": 1}, analysis.Adverbs.Words)
+	assert.Equal(t, []article.RepeatedWord{{Word: "tulisan", Count: 5}}, analysis.RepeatedWords)
+	assert.Greater(t, analysis.Readability.Indonesian.Score, analysis.Readability.English.Score)
+}
+
+func TestAnalyzeContent_Empty(t *testing.T) {
+	analysis := article.AnalyzeContent("", "id")
+
+	assert.Equal(t, 0, analysis.Words)
+	assert.Empty(t, analysis.Warnings)
+	assert.Len(t, analysis.SentenceLengths.Buckets, 5)
+}
+
+func TestAnalysisUsecaseAnalyze_NotOwner(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "email@gmail.co").Return(entity.Account{ID: 2}, nil)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Author: entity.Account{ID: 1}}, nil)
+
+	u := article.NewAnalysisUsecase(articleRepo, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Analyze(ctx, article.AnalyzeArticleRequest{ID: 1})
+	assert.Equal(t, exception.ErrBadRequest, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+}
@@ -0,0 +1,36 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type AnalysisUsecase interface {
+	Analyze(ctx context.Context, params AnalyzeArticleRequest) (resp response.Response)
+}
+
+type analysisUsecaseImpl struct {
+	articleRepo ArticleRepository
+	accountRepo account.AccountRepository
+}
+
+func NewAnalysisUsecase(articleRepo ArticleRepository, accountRepo account.AccountRepository) AnalysisUsecase {
+	return &analysisUsecaseImpl{
+		articleRepo: articleRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+// Analyze gives the author feedback on the writing of the article, whatever its status.
+func (u *analysisUsecaseImpl) Analyze(ctx context.Context, params AnalyzeArticleRequest) (resp response.Response) {
+	article, resp := findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ID)
+	if resp != nil {
+		return
+	}
+
+	analysis := AnalyzeContent(article.Content, article.Language)
+
+	return response.Success(response.StatusOK, analysis)
+}
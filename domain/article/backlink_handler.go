@@ -0,0 +1,59 @@
+package article
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type BacklinkHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  BacklinkUsecase
+}
+
+func NewBacklinkHTTPHandler(
+	router *mux.Router,
+	basicAuthMiddleware middleware.RouteMiddleware,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase BacklinkUsecase,
+) {
+	handler := &BacklinkHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/article/{id:[0-9]+}/backlinks", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, handler.GetBacklinks)).Methods(http.MethodGet)
+}
+
+func (handler *BacklinkHTTPHandler) GetBacklinks(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetBacklinksRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetBacklinks(ctx, params)
+	resp.JSON(w)
+}
@@ -0,0 +1,51 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type BacklinkUsecase interface {
+	GetBacklinks(ctx context.Context, params GetBacklinksRequest) (resp response.Response)
+}
+
+type backlinkUsecaseImpl struct {
+	repository     ArticleRepository
+	linkRepository ArticleLinkRepository
+}
+
+func NewBacklinkUsecase(repository ArticleRepository, linkRepository ArticleLinkRepository) BacklinkUsecase {
+	return &backlinkUsecaseImpl{
+		repository:     repository,
+		linkRepository: linkRepository,
+	}
+}
+
+func (u *backlinkUsecaseImpl) GetBacklinks(ctx context.Context, params GetBacklinksRequest) (resp response.Response) {
+	article, err := u.repository.FindByID(ctx, params.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	//Anonymous readers only get to see what is live
+	if !isAuthenticated(ctx) && article.Status != ArticleStatusPublished && article.Status != ArticleStatusUnlisted {
+		return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	//Only published articles link to it as far as readers are concerned
+	sources, err := u.linkRepository.FindBacklinks(ctx, article.ID, ArticleStatusPublished)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if sources == nil {
+		sources = []LinkedArticle{}
+	}
+
+	return response.Success(response.StatusOK, sources)
+}
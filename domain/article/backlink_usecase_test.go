@@ -0,0 +1,44 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+func TestBacklinkUsecaseGetBacklinks_Success(t *testing.T) {
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusPublished}, nil)
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("FindBacklinks", mock.Anything, int64(1), article.ArticleStatusPublished).Return([]article.LinkedArticle{
+		{ID: 2, Title: "Linking", Status: article.ArticleStatusPublished},
+	}, nil)
+
+	u := article.NewBacklinkUsecase(articleRepo, linkRepo)
+
+	resp := u.GetBacklinks(context.TODO(), article.GetBacklinksRequest{ID: 1})
+	assert.NoError(t, resp.Err())
+
+	articleRepo.AssertExpectations(t)
+	linkRepo.AssertExpectations(t)
+}
+
+func TestBacklinkUsecaseGetBacklinks_DraftHiddenFromAnonymous(t *testing.T) {
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft}, nil)
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+
+	u := article.NewBacklinkUsecase(articleRepo, linkRepo)
+
+	resp := u.GetBacklinks(context.TODO(), article.GetBacklinksRequest{ID: 1})
+	assert.Equal(t, exception.ErrNotFound, resp.Err())
+
+	articleRepo.AssertExpectations(t)
+	linkRepo.AssertExpectations(t)
+}
@@ -0,0 +1,212 @@
+package article
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	rv8 "github.com/go-redis/redis/v8"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+// articleStatuses are all the statuses an article can be in.
+var articleStatuses = []ArticleStatus{
+	ArticleStatusDraft,
+	ArticleStatusInReview,
+	ArticleStatusScheduled,
+	ArticleStatusPublished,
+	ArticleStatusUnlisted,
+	ArticleStatusArchived,
+}
+
+// cacheInvalidatedTTL is how long a key stays invalidated after a write. A read that started before the write
+// finishes within it, it must not put the row it read back in the cache.
+const cacheInvalidatedTTL = time.Second * 10
+
+// cacheUnlessInvalidated fills the key unless it was invalidated since, the check and the write are atomic.
+var cacheUnlessInvalidated = rv8.NewScript(`
+if redis.call("EXISTS", KEYS[2]) == 1 then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
+// cachedArticleRepository is a read-through cache over the article repository.
+// Redis failures never fail a request, the read simply goes to the wrapped repository.
+type cachedArticleRepository struct {
+	ArticleRepository
+	c         rv8.UniversalClient
+	ttl       time.Duration
+	keyPrefix string
+	group     singleflight.Group
+}
+
+// NewCachedArticleRepository wraps the repository so single article and listing reads are served from Redis.
+func NewCachedArticleRepository(repository ArticleRepository, rdb rv8.UniversalClient, ttl time.Duration, keyPrefix string) ArticleRepository {
+	return &cachedArticleRepository{
+		ArticleRepository: repository,
+		c:                 rdb,
+		ttl:               ttl,
+		keyPrefix:         keyPrefix,
+	}
+}
+
+func (r *cachedArticleRepository) idKey(ID int64) string {
+	return fmt.Sprintf("%s:id:%d", r.keyPrefix, ID)
+}
+
+func (r *cachedArticleRepository) allKey() string {
+	return fmt.Sprintf("%s:all", r.keyPrefix)
+}
+
+func (r *cachedArticleRepository) statusKey(status ArticleStatus) string {
+	return fmt.Sprintf("%s:status:%s", r.keyPrefix, status)
+}
+
+// invalidatedKey marks the key as invalidated. The key is its hash tag, both live in the same cluster slot.
+func (r *cachedArticleRepository) invalidatedKey(key string) string {
+	return fmt.Sprintf("{%s}:invalidated", key)
+}
+
+func (r *cachedArticleRepository) Save(ctx context.Context, article Article) (ID int64, err error) {
+	ID, err = r.ArticleRepository.Save(ctx, article)
+	if err != nil {
+		return
+	}
+
+	r.invalidate(ctx, r.allKey(), r.statusKey(article.Status))
+
+	return
+}
+
+func (r *cachedArticleRepository) Update(ctx context.Context, ID int64, authorId int64, updatedArticle Article) (err error) {
+	err = r.ArticleRepository.Update(ctx, ID, authorId, updatedArticle)
+	if err != nil {
+		return
+	}
+
+	r.invalidate(ctx, r.articleKeys(ID)...)
+
+	return
+}
+
+func (r *cachedArticleRepository) UpdateStatus(ctx context.Context, ID int64, authorId int64, currentStatus ArticleStatus, updatedArticle Article) (err error) {
+	err = r.ArticleRepository.UpdateStatus(ctx, ID, authorId, currentStatus, updatedArticle)
+	if err != nil {
+		return
+	}
+
+	r.invalidate(ctx, r.articleKeys(ID)...)
+
+	return
+}
+
+func (r *cachedArticleRepository) FindByID(ctx context.Context, ID int64) (article Article, err error) {
+	err = r.load(ctx, r.idKey(ID), &article, func() (interface{}, error) {
+		return r.ArticleRepository.FindByID(ctx, ID)
+	})
+
+	return
+}
+
+func (r *cachedArticleRepository) FindMany(ctx context.Context) (bunchOfArticles []Article, err error) {
+	err = r.load(ctx, r.allKey(), &bunchOfArticles, func() (interface{}, error) {
+		return r.ArticleRepository.FindMany(ctx)
+	})
+
+	return
+}
+
+func (r *cachedArticleRepository) FindManyByStatus(ctx context.Context, status ArticleStatus) (bunchOfArticles []Article, err error) {
+	err = r.load(ctx, r.statusKey(status), &bunchOfArticles, func() (interface{}, error) {
+		return r.ArticleRepository.FindManyByStatus(ctx, status)
+	})
+
+	return
+}
+
+// articleKeys lists every key an edit of the article may have made stale.
+func (r *cachedArticleRepository) articleKeys(ID int64) (keys []string) {
+	keys = append(keys, r.idKey(ID), r.allKey())
+	for _, status := range articleStatuses {
+		keys = append(keys, r.statusKey(status))
+	}
+
+	return
+}
+
+// load reads the key into dest, on a miss only one caller per key goes to the wrapped repository and fills the cache.
+// A read within a transaction goes straight to the wrapped repository, it has to see the writes of the transaction
+// and those must not reach the cache or other callers before they are committed.
+func (r *cachedArticleRepository) load(ctx context.Context, key string, dest interface{}, fetch func() (interface{}, error)) (err error) {
+	if database.InTransaction(ctx) {
+		value, err := fetch()
+		if err != nil {
+			return err
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+
+		return json.Unmarshal(encoded, dest)
+	}
+
+	cached, err := r.c.Get(ctx, key).Bytes()
+	if err == nil {
+		if err = json.Unmarshal(cached, dest); err == nil {
+			return
+		}
+	}
+	if err != nil && err != rv8.Nil {
+		log.Println(err)
+	}
+
+	value, err, _ := r.group.Do(key, func() (interface{}, error) {
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			log.Println(err)
+			return nil, exception.ErrInternalServer
+		}
+
+		err = cacheUnlessInvalidated.Run(ctx, r.c, []string{key, r.invalidatedKey(key)}, encoded, r.ttl.Milliseconds()).Err()
+		if err != nil {
+			log.Println(err)
+		}
+
+		return encoded, nil
+	})
+	if err != nil {
+		return
+	}
+
+	return json.Unmarshal(value.([]byte), dest)
+}
+
+// invalidate deletes the keys and keeps them from being filled again for a while,
+// by a read that loaded the row before the write.
+func (r *cachedArticleRepository) invalidate(ctx context.Context, keys ...string) {
+	_, err := r.c.TxPipelined(ctx, func(pipe rv8.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		for _, key := range keys {
+			pipe.Set(ctx, r.invalidatedKey(key), 1, cacheInvalidatedTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
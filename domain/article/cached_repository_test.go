@@ -0,0 +1,142 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	redismock "github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+// anyScriptSHA matches a script call by its keys and arguments, the digest of the script is left out.
+func anyScriptSHA(expected, actual []interface{}) error {
+	if !reflect.DeepEqual(expected[2:], actual[2:]) {
+		return fmt.Errorf("expected script call %v, got %v", expected, actual)
+	}
+	return nil
+}
+
+func TestCachedArticleRepositoryFindByID_Miss(t *testing.T) {
+	stored := article.Article{ID: 1, Title: "Title", Status: article.ArticleStatusPublished}
+	encoded, _ := json.Marshal(stored)
+
+	repository := new(articleMocks.ArticleRepository)
+	repository.On("FindByID", mock.Anything, int64(1)).Return(stored, nil).Once()
+
+	rdb, redisMock := redismock.NewClientMock()
+	redisMock.ExpectGet("article:cache:id:1").RedisNil()
+	redisMock.CustomMatch(anyScriptSHA).
+		ExpectEvalSha("", []string{"article:cache:id:1", "{article:cache:id:1}:invalidated"}, encoded, int64(60000)).
+		SetVal(int64(1))
+
+	cached := article.NewCachedArticleRepository(repository, rdb, time.Minute, "article:cache")
+
+	got, err := cached.FindByID(context.TODO(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored.Title, got.Title)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+	repository.AssertExpectations(t)
+}
+
+func TestCachedArticleRepositoryFindByID_Hit(t *testing.T) {
+	stored := article.Article{ID: 1, Title: "Title", Status: article.ArticleStatusPublished}
+	encoded, _ := json.Marshal(stored)
+
+	repository := new(articleMocks.ArticleRepository)
+
+	rdb, redisMock := redismock.NewClientMock()
+	redisMock.ExpectGet("article:cache:id:1").SetVal(string(encoded))
+
+	cached := article.NewCachedArticleRepository(repository, rdb, time.Minute, "article:cache")
+
+	got, err := cached.FindByID(context.TODO(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored.Title, got.Title)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+	repository.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestCachedArticleRepositoryFindByID_InvalidatedMeanwhileIsNotCached(t *testing.T) {
+	stored := article.Article{ID: 1, Title: "Title", Status: article.ArticleStatusPublished}
+	encoded, _ := json.Marshal(stored)
+
+	repository := new(articleMocks.ArticleRepository)
+	repository.On("FindByID", mock.Anything, int64(1)).Return(stored, nil).Once()
+
+	//The article was written while it was read, the script finds the marker and caches nothing
+	rdb, redisMock := redismock.NewClientMock()
+	redisMock.ExpectGet("article:cache:id:1").RedisNil()
+	redisMock.CustomMatch(anyScriptSHA).
+		ExpectEvalSha("", []string{"article:cache:id:1", "{article:cache:id:1}:invalidated"}, encoded, int64(60000)).
+		SetVal(int64(0))
+
+	cached := article.NewCachedArticleRepository(repository, rdb, time.Minute, "article:cache")
+
+	got, err := cached.FindByID(context.TODO(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored.Title, got.Title)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+	repository.AssertExpectations(t)
+}
+
+func TestCachedArticleRepositoryFindByID_NotFoundIsNotCached(t *testing.T) {
+	repository := new(articleMocks.ArticleRepository)
+	repository.On("FindByID", mock.Anything, int64(1)).Return(article.Article{}, exception.ErrNotFound).Once()
+
+	rdb, redisMock := redismock.NewClientMock()
+	redisMock.ExpectGet("article:cache:id:1").RedisNil()
+
+	cached := article.NewCachedArticleRepository(repository, rdb, time.Minute, "article:cache")
+
+	_, err := cached.FindByID(context.TODO(), 1)
+
+	assert.Equal(t, exception.ErrNotFound, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+	repository.AssertExpectations(t)
+}
+
+func TestCachedArticleRepositoryUpdateStatus_Invalidates(t *testing.T) {
+	updated := article.Article{Status: article.ArticleStatusArchived}
+
+	repository := new(articleMocks.ArticleRepository)
+	repository.On("UpdateStatus", mock.Anything, int64(1), int64(2), article.ArticleStatusPublished, updated).Return(nil).Once()
+
+	keys := []string{
+		"article:cache:id:1",
+		"article:cache:all",
+		"article:cache:status:DRAFT",
+		"article:cache:status:IN_REVIEW",
+		"article:cache:status:SCHEDULED",
+		"article:cache:status:PUBLISHED",
+		"article:cache:status:UNLISTED",
+		"article:cache:status:ARCHIVED",
+	}
+
+	rdb, redisMock := redismock.NewClientMock()
+	redisMock.ExpectTxPipeline()
+	redisMock.ExpectDel(keys...).SetVal(2)
+	for _, key := range keys {
+		redisMock.ExpectSet("{"+key+"}:invalidated", 1, time.Second*10).SetVal("OK")
+	}
+	redisMock.ExpectTxPipelineExec()
+
+	cached := article.NewCachedArticleRepository(repository, rdb, time.Minute, "article:cache")
+
+	err := cached.UpdateStatus(context.TODO(), 1, 2, article.ArticleStatusPublished, updated)
+
+	assert.NoError(t, err)
+	assert.NoError(t, redisMock.ExpectationsWereMet())
+	repository.AssertExpectations(t)
+}
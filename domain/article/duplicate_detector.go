@@ -0,0 +1,172 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"time"
+)
+
+const (
+	// duplicateMaxDistance is the largest number of differing SimHash bits still counted as a near-duplicate.
+	duplicateMaxDistance = 3
+	// duplicateMinimumTerms keeps very short texts out, their fingerprints collide too easily.
+	duplicateMinimumTerms = 8
+	duplicateShingleSize  = 3
+	// duplicateBands is the number of 16 bit parts a fingerprint is split in. It is larger than duplicateMaxDistance,
+	// so a near-duplicate always has one part in common and only the articles sharing one have to be compared.
+	duplicateBands = 4
+)
+
+// DuplicateDetector fingerprints articles and finds the near-duplicates among the known ones.
+type DuplicateDetector struct {
+	location   *time.Location
+	repository DuplicateRepository
+}
+
+// NewDuplicateDetector is a constructor.
+func NewDuplicateDetector(location *time.Location, repository DuplicateRepository) *DuplicateDetector {
+	return &DuplicateDetector{
+		location:   location,
+		repository: repository,
+	}
+}
+
+// Register checks every article again once it is published, the content may have changed since it was created.
+func (d *DuplicateDetector) Register(m *ArticleStateMachine) {
+	m.AfterEnter(ArticleStatusPublished, d.checkPublished)
+}
+
+func (d *DuplicateDetector) checkPublished(ctx context.Context, transition *StatusTransition) (err error) {
+	matches, err := d.Check(ctx, transition.Article)
+	if err != nil {
+		return
+	}
+
+	for _, match := range matches {
+		transition.Warnings = append(transition.Warnings, duplicateWarning(transition.Article.ID, match))
+	}
+
+	return
+}
+
+// Check stores the fingerprint of the article and records every known article it nearly duplicates.
+// The article that was created first is taken as the original, whichever of the two is being checked.
+func (d *DuplicateDetector) Check(ctx context.Context, article Article) (matches []DuplicateMatch, err error) {
+	fingerprint, ok := simHash(article.Content)
+	if !ok {
+		return
+	}
+
+	known, err := d.repository.FindSimilarFingerprints(ctx, fingerprint)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().In(d.location)
+	createdAt := article.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+
+	for _, other := range known {
+		if other.ArticleID == article.ID {
+			continue
+		}
+
+		distance := bits.OnesCount64(fingerprint ^ other.Fingerprint)
+		if distance > duplicateMaxDistance {
+			continue
+		}
+
+		match := DuplicateMatch{}
+		match.ArticleID = article.ID
+		match.MatchedArticleID = other.ArticleID
+		if createdEarlier(article.ID, createdAt, other.ArticleID, other.CreatedAt) {
+			match.ArticleID = other.ArticleID
+			match.MatchedArticleID = article.ID
+		}
+		match.Distance = distance
+		match.Similarity = 1 - float64(distance)/64
+		match.Kind = DuplicateKindCopy
+		if other.AuthorID == article.Author.ID {
+			match.Kind = DuplicateKindSelfRepost
+		}
+		match.DetectedAt = now
+
+		err = d.repository.SaveMatch(ctx, match)
+		if err != nil {
+			return
+		}
+
+		matches = append(matches, match)
+	}
+
+	err = d.repository.SaveFingerprint(ctx, ArticleFingerprint{
+		ArticleID:   article.ID,
+		AuthorID:    article.Author.ID,
+		Fingerprint: fingerprint,
+		CreatedAt:   createdAt,
+	})
+
+	return
+}
+
+// createdEarlier tells whether the first article came before the second one, the lower ID wins a tie.
+func createdEarlier(ID int64, createdAt time.Time, otherID int64, otherCreatedAt time.Time) bool {
+	if createdAt.Equal(otherCreatedAt) {
+		return ID < otherID
+	}
+
+	return createdAt.Before(otherCreatedAt)
+}
+
+func duplicateWarning(ID int64, match DuplicateMatch) string {
+	if match.ArticleID != ID {
+		return fmt.Sprintf("article %d is a near-duplicate of this one (%.0f%% similar, %s)", match.ArticleID, match.Similarity*100, match.Kind)
+	}
+
+	return fmt.Sprintf("near-duplicate of article %d (%.0f%% similar, %s)", match.MatchedArticleID, match.Similarity*100, match.Kind)
+}
+
+// fingerprintBands splits the fingerprint in the parts the similar fingerprints are looked up by.
+func fingerprintBands(fingerprint uint64) (bands [duplicateBands]uint16) {
+	for i := range bands {
+		bands[i] = uint16(fingerprint >> uint(16*i))
+	}
+
+	return
+}
+
+// simHash fingerprints the text from its word shingles, false means the text is too short to judge.
+func simHash(text string) (fingerprint uint64, ok bool) {
+	terms := tokenize(text)
+	if len(terms) < duplicateMinimumTerms {
+		return
+	}
+
+	var weights [64]int
+	for i := 0; i+duplicateShingleSize <= len(terms); i++ {
+		hash := fnv.New64a()
+		hash.Write([]byte(strings.Join(terms[i:i+duplicateShingleSize], " ")))
+		sum := hash.Sum64()
+
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	for bit, weight := range weights {
+		if weight > 0 {
+			fingerprint |= 1 << uint(bit)
+		}
+	}
+
+	return fingerprint, true
+}
@@ -0,0 +1,133 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+)
+
+const dataDuplicatedContent = "Clean architecture keeps the business rules independent from frameworks, databases and delivery mechanisms so they can be tested in isolation."
+
+// fingerprintOf lets the detector compute the fingerprint of a content and hands it back through the repository.
+func fingerprintOf(t *testing.T, content string) uint64 {
+	duplicateRepo := new(articleMocks.DuplicateRepository)
+	duplicateRepo.On("FindSimilarFingerprints", mock.Anything, mock.Anything).Return(nil, nil)
+
+	var fingerprint uint64
+	duplicateRepo.On("SaveFingerprint", mock.Anything, mock.MatchedBy(func(f article.ArticleFingerprint) bool {
+		fingerprint = f.Fingerprint
+		return true
+	})).Return(nil)
+
+	_, err := article.NewDuplicateDetector(location, duplicateRepo).Check(context.TODO(), article.Article{ID: 1, Content: content})
+	assert.NoError(t, err)
+
+	return fingerprint
+}
+
+func TestDuplicateDetectorCheck_CopyOfOthersWork(t *testing.T) {
+	original := fingerprintOf(t, dataDuplicatedContent)
+
+	duplicateRepo := new(articleMocks.DuplicateRepository)
+	duplicateRepo.On("FindSimilarFingerprints", mock.Anything, mock.Anything).Return([]article.ArticleFingerprint{
+		{ArticleID: 1, AuthorID: 7, Fingerprint: original},
+	}, nil)
+	duplicateRepo.On("SaveMatch", mock.Anything, mock.MatchedBy(func(match article.DuplicateMatch) bool {
+		return match.ArticleID == 2 && match.MatchedArticleID == 1 && match.Kind == article.DuplicateKindCopy
+	})).Return(nil)
+	duplicateRepo.On("SaveFingerprint", mock.Anything, mock.AnythingOfType("article.ArticleFingerprint")).Return(nil)
+
+	detector := article.NewDuplicateDetector(location, duplicateRepo)
+
+	matches, err := detector.Check(context.TODO(), article.Article{ID: 2, Content: dataDuplicatedContent, Author: entity.Account{ID: 8}})
+
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, 0, matches[0].Distance)
+
+	duplicateRepo.AssertExpectations(t)
+}
+
+func TestDuplicateDetectorCheck_ShortContent(t *testing.T) {
+	duplicateRepo := new(articleMocks.DuplicateRepository)
+
+	detector := article.NewDuplicateDetector(location, duplicateRepo)
+
+	matches, err := detector.Check(context.TODO(), article.Article{ID: 2, Content: "too short"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+
+	duplicateRepo.AssertExpectations(t)
+}
+
+func TestDuplicateDetector_WarnsOnPublish(t *testing.T) {
+	original := fingerprintOf(t, dataDuplicatedContent)
+
+	duplicateRepo := new(articleMocks.DuplicateRepository)
+	duplicateRepo.On("FindSimilarFingerprints", mock.Anything, mock.Anything).Return([]article.ArticleFingerprint{
+		{ArticleID: 1, AuthorID: 8, Fingerprint: original},
+	}, nil)
+	duplicateRepo.On("SaveMatch", mock.Anything, mock.AnythingOfType("article.DuplicateMatch")).Return(nil)
+	duplicateRepo.On("SaveFingerprint", mock.Anything, mock.AnythingOfType("article.ArticleFingerprint")).Return(nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewDuplicateDetector(location, duplicateRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusDraft,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 2, Content: dataDuplicatedContent, Status: article.ArticleStatusDraft, Author: entity.Account{ID: 8}},
+		At:      time.Now().In(location),
+	}
+
+	assert.NoError(t, m.Apply(context.TODO(), transition))
+	m.Complete(context.TODO(), transition)
+
+	assert.Len(t, transition.Warnings, 1)
+	assert.Contains(t, transition.Warnings[0], string(article.DuplicateKindSelfRepost))
+
+	duplicateRepo.AssertExpectations(t)
+}
+
+func TestDuplicateDetectorCheck_OriginalCheckedLater(t *testing.T) {
+	original := fingerprintOf(t, dataDuplicatedContent)
+	createdAt := time.Date(2021, 10, 1, 8, 0, 0, 0, location)
+
+	//The copy was fingerprinted when it was created, the original is only checked when it gets published
+	duplicateRepo := new(articleMocks.DuplicateRepository)
+	duplicateRepo.On("FindSimilarFingerprints", mock.Anything, original).Return([]article.ArticleFingerprint{
+		{ArticleID: 2, AuthorID: 8, Fingerprint: original, CreatedAt: createdAt.Add(time.Hour)},
+	}, nil)
+	duplicateRepo.On("SaveMatch", mock.Anything, mock.MatchedBy(func(match article.DuplicateMatch) bool {
+		return match.ArticleID == 2 && match.MatchedArticleID == 1 && match.Kind == article.DuplicateKindCopy
+	})).Return(nil)
+	duplicateRepo.On("SaveFingerprint", mock.Anything, mock.MatchedBy(func(fingerprint article.ArticleFingerprint) bool {
+		return fingerprint.ArticleID == 1 && fingerprint.CreatedAt.Equal(createdAt)
+	})).Return(nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewDuplicateDetector(location, duplicateRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusDraft,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 1, Content: dataDuplicatedContent, Status: article.ArticleStatusDraft, Author: entity.Account{ID: 7}, CreatedAt: createdAt},
+		At:      time.Now().In(location),
+	}
+
+	assert.NoError(t, m.Apply(context.TODO(), transition))
+	m.Complete(context.TODO(), transition)
+
+	assert.Len(t, transition.Warnings, 1)
+	assert.Contains(t, transition.Warnings[0], "article 2 is a near-duplicate of this one")
+
+	duplicateRepo.AssertExpectations(t)
+}
@@ -0,0 +1,34 @@
+package article
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type DuplicateHTTPHandler struct {
+	Usecase DuplicateUsecase
+}
+
+func NewDuplicateHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	usecase DuplicateUsecase,
+) {
+	handler := &DuplicateHTTPHandler{
+		Usecase: usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/admin/duplicates", bearerAuthMiddleware.VerifyBearer(handler.GetReport)).Methods(http.MethodGet)
+}
+
+func (handler *DuplicateHTTPHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var ctx = r.Context()
+
+	resp = handler.Usecase.GetReport(ctx)
+	resp.JSON(w)
+}
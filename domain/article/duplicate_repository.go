@@ -0,0 +1,197 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type DuplicateRepository interface {
+	SaveFingerprint(ctx context.Context, fingerprint ArticleFingerprint) (err error)
+	FindSimilarFingerprints(ctx context.Context, fingerprint uint64) (fingerprints []ArticleFingerprint, err error)
+	SaveMatch(ctx context.Context, match DuplicateMatch) (err error)
+	FindMatches(ctx context.Context) (matches []DuplicateMatch, err error)
+}
+
+type duplicateRepositoryImpl struct {
+	db                   *sql.DB
+	fingerprintTableName string
+	matchTableName       string
+}
+
+func NewDuplicateRepository(db *sql.DB, fingerprintTableName string, matchTableName string) DuplicateRepository {
+	return &duplicateRepositoryImpl{
+		db:                   db,
+		fingerprintTableName: fingerprintTableName,
+		matchTableName:       matchTableName,
+	}
+}
+
+// The fingerprints are kept as hex so they survive drivers without unsigned 64 bit support.
+func formatFingerprint(fingerprint uint64) string {
+	return fmt.Sprintf("%016x", fingerprint)
+}
+
+// SaveFingerprint keeps the time the article was first fingerprinted, it decides which of two near-duplicates is the original.
+func (r *duplicateRepositoryImpl) SaveFingerprint(ctx context.Context, fingerprint ArticleFingerprint) (err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (articleId, authorId, fingerprint, band0, band1, band2, band3, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE fingerprint = VALUES(fingerprint), band0 = VALUES(band0), band1 = VALUES(band1), band2 = VALUES(band2), band3 = VALUES(band3)`, r.fingerprintTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	bands := fingerprintBands(fingerprint.Fingerprint)
+	_, err = stmt.ExecContext(
+		ctx,
+		fingerprint.ArticleID,
+		fingerprint.AuthorID,
+		formatFingerprint(fingerprint.Fingerprint),
+		bands[0],
+		bands[1],
+		bands[2],
+		bands[3],
+		fingerprint.CreatedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+// FindSimilarFingerprints finds the fingerprints sharing a band with the given one, the only ones that can be near-duplicates.
+func (r *duplicateRepositoryImpl) FindSimilarFingerprints(ctx context.Context, fingerprint uint64) (fingerprints []ArticleFingerprint, err error) {
+	query := fmt.Sprintf(`SELECT articleId, authorId, fingerprint, createdAt FROM %s WHERE band0 = ? OR band1 = ? OR band2 = ? OR band3 = ?`, r.fingerprintTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	bands := fingerprintBands(fingerprint)
+	rows, err := stmt.QueryContext(ctx, bands[0], bands[1], bands[2], bands[3])
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		known := ArticleFingerprint{}
+		var hash string
+
+		err = rows.Scan(
+			&known.ArticleID,
+			&known.AuthorID,
+			&hash,
+			&known.CreatedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		known.Fingerprint, err = strconv.ParseUint(hash, 16, 64)
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		fingerprints = append(fingerprints, known)
+	}
+
+	return
+}
+
+func (r *duplicateRepositoryImpl) SaveMatch(ctx context.Context, match DuplicateMatch) (err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (articleId, matchedArticleId, distance, similarity, kind, detectedAt) VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE distance = VALUES(distance), similarity = VALUES(similarity), kind = VALUES(kind), detectedAt = VALUES(detectedAt)`, r.matchTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		match.ArticleID,
+		match.MatchedArticleID,
+		match.Distance,
+		match.Similarity,
+		match.Kind,
+		match.DetectedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (r *duplicateRepositoryImpl) FindMatches(ctx context.Context) (matches []DuplicateMatch, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, matchedArticleId, distance, similarity, kind, detectedAt FROM %s ORDER BY detectedAt DESC`, r.matchTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		match := DuplicateMatch{}
+
+		err = rows.Scan(
+			&match.ID,
+			&match.ArticleID,
+			&match.MatchedArticleID,
+			&match.Distance,
+			&match.Similarity,
+			&match.Kind,
+			&match.DetectedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		matches = append(matches, match)
+	}
+
+	return
+}
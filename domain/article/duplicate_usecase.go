@@ -0,0 +1,48 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type DuplicateUsecase interface {
+	GetReport(ctx context.Context) (resp response.Response)
+}
+
+type duplicateUsecaseImpl struct {
+	repository  DuplicateRepository
+	accountRepo account.AccountRepository
+}
+
+func NewDuplicateUsecase(repository DuplicateRepository, accountRepo account.AccountRepository) DuplicateUsecase {
+	return &duplicateUsecaseImpl{
+		repository:  repository,
+		accountRepo: accountRepo,
+	}
+}
+
+func (u *duplicateUsecaseImpl) GetReport(ctx context.Context) (resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if !account.HasRole(entity.AccountRoleAdmin) {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrUnauthorized)
+	}
+
+	matches, err := u.repository.FindMatches(ctx)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, matches)
+}
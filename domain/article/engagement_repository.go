@@ -0,0 +1,100 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type EngagementRepository interface {
+	Record(ctx context.Context, engagement ArticleEngagement) (err error)
+	AggregatePublishedSince(ctx context.Context, since time.Time, at time.Time) (buckets []EngagementBucket, err error)
+}
+
+type engagementRepositoryImpl struct {
+	db               *sql.DB
+	tableName        string
+	articleTableName string
+}
+
+func NewEngagementRepository(db *sql.DB, tableName string, articleTableName string) EngagementRepository {
+	return &engagementRepositoryImpl{
+		db:               db,
+		tableName:        tableName,
+		articleTableName: articleTableName,
+	}
+}
+
+func (r *engagementRepositoryImpl) Record(ctx context.Context, engagement ArticleEngagement) (err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (articleId, kind, occurredAt) VALUES (?, ?, ?)`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, engagement.ArticleID, engagement.Kind, engagement.OccurredAt)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+// AggregatePublishedSince counts the interactions with published articles per hour of age, so the decay can be applied without reading every event.
+func (r *engagementRepositoryImpl) AggregatePublishedSince(ctx context.Context, since time.Time, at time.Time) (buckets []EngagementBucket, err error) {
+	query := fmt.Sprintf(
+		`SELECT e.articleId, e.kind, TIMESTAMPDIFF(HOUR, e.occurredAt, ?) AS ageHours, COUNT(*)
+		FROM %s e JOIN %s a ON a.id = e.articleId
+		WHERE e.occurredAt >= ? AND a.status = ?
+		GROUP BY e.articleId, e.kind, ageHours`,
+		r.tableName,
+		r.articleTableName,
+	)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, at, since, ArticleStatusPublished)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		bucket := EngagementBucket{}
+
+		err = rows.Scan(
+			&bucket.ArticleID,
+			&bucket.Kind,
+			&bucket.AgeHours,
+			&bucket.Count,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	return
+}
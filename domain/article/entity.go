@@ -3,6 +3,7 @@ package article
 import (
 	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
 )
 
@@ -11,19 +12,268 @@ type ArticleStatus string
 
 const (
 	ArticleStatusDraft     ArticleStatus = "DRAFT"
+	ArticleStatusInReview  ArticleStatus = "IN_REVIEW"
+	ArticleStatusScheduled ArticleStatus = "SCHEDULED"
 	ArticleStatusPublished ArticleStatus = "PUBLISHED"
+	ArticleStatusUnlisted  ArticleStatus = "UNLISTED"
 	ArticleStatusArchived  ArticleStatus = "ARCHIVED"
 )
 
+// IsUnreleased reports whether the article has not gone live yet.
+func (s ArticleStatus) IsUnreleased() bool {
+	return s == ArticleStatusDraft || s == ArticleStatusInReview || s == ArticleStatusScheduled
+}
+
+// releasedStatuses are the statuses of articles that went live, an archived article was live before.
+var releasedStatuses = []ArticleStatus{ArticleStatusPublished, ArticleStatusUnlisted, ArticleStatusArchived}
+
+// ArticleAccessLevel tells who may read the full content of an article.
+type ArticleAccessLevel string
+
+const (
+	ArticleAccessLevelPublic  ArticleAccessLevel = "PUBLIC"
+	ArticleAccessLevelMembers ArticleAccessLevel = "MEMBERS"
+)
+
 // Article is a collection of property of article.
 type Article struct {
-	ID             int64          `json:"id"`
-	Title          string         `json:"title"`
-	Subtitle       string         `json:"subtitle"`
-	Content        string         `json:"content"`
-	Status         ArticleStatus  `json:"status"`
-	CreatedAt      time.Time      `json:"createdAt"`
-	PublishedAt    *time.Time     `json:"publishedAt"`
-	LastModifiedAt *time.Time     `json:"lastModifiedAt"`
-	Author         entity.Account `json:"author"`
+	ID             int64              `json:"id"`
+	Title          string             `json:"title"`
+	Subtitle       string             `json:"subtitle"`
+	Content        string             `json:"content"`
+	Language       string             `json:"language"`
+	AccessLevel    ArticleAccessLevel `json:"accessLevel"`
+	Locked         bool               `json:"locked"`
+	Status         ArticleStatus      `json:"status"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	PublishedAt    *time.Time         `json:"publishedAt"`
+	LastModifiedAt *time.Time         `json:"lastModifiedAt"`
+	Author         entity.Account     `json:"author"`
+	Slug           string             `json:"slug"`
+}
+
+// ModifiedAt is the last time readers could have seen the article change.
+// Rows whose status changed before status changes stamped lastModifiedAt may have gone live after their last edit,
+// a planned publish time of a scheduled article has not happened yet.
+func (a Article) ModifiedAt() time.Time {
+	modifiedAt := a.CreatedAt
+	if a.LastModifiedAt != nil && a.LastModifiedAt.After(modifiedAt) {
+		modifiedAt = *a.LastModifiedAt
+	}
+	if a.PublishedAt != nil && a.PublishedAt.After(modifiedAt) && !a.PublishedAt.After(time.Now()) {
+		modifiedAt = *a.PublishedAt
+	}
+	return modifiedAt
+}
+
+// latestModifiedAt is the most recent change among the articles, it stamps listings.
+func latestModifiedAt(articles []Article) (latest time.Time) {
+	for _, article := range articles {
+		if modifiedAt := article.ModifiedAt(); modifiedAt.After(latest) {
+			latest = modifiedAt
+		}
+	}
+	return
+}
+
+// ArticleDefaultLanguage is the language of articles created without one.
+const ArticleDefaultLanguage = "id"
+
+// PreviewLink is a shareable and revocable link to a draft article.
+type PreviewLink struct {
+	ID        string     `json:"id"`
+	ArticleID int64      `json:"articleId"`
+	CreatedBy int64      `json:"createdBy"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt"`
+}
+
+// PreviewView is a record of someone opening a preview link.
+type PreviewView struct {
+	ID        int64     `json:"id"`
+	LinkID    string    `json:"linkId"`
+	Viewer    string    `json:"viewer"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	ViewedAt  time.Time `json:"viewedAt"`
+}
+
+// PreviewLinkClaims is a model of preview link token claims.
+// The link ID is carried as the standard `jti` claim.
+type PreviewLinkClaims struct {
+	jwt.StandardClaims
+	ArticleID int64 `json:"articleId"`
+}
+
+// ReviewStatus is a type of editorial review current status.
+type ReviewStatus string
+
+const (
+	ReviewStatusPending          ReviewStatus = "PENDING"
+	ReviewStatusClaimed          ReviewStatus = "CLAIMED"
+	ReviewStatusApproved         ReviewStatus = "APPROVED"
+	ReviewStatusChangesRequested ReviewStatus = "CHANGES_REQUESTED"
+)
+
+// ReviewDecisionType is a type of decision taken by a reviewer.
+type ReviewDecisionType string
+
+const (
+	ReviewDecisionClaimed          ReviewDecisionType = "CLAIMED"
+	ReviewDecisionApproved         ReviewDecisionType = "APPROVED"
+	ReviewDecisionChangesRequested ReviewDecisionType = "CHANGES_REQUESTED"
+)
+
+// ArticleReview is an editorial review of a submitted article.
+type ArticleReview struct {
+	ID          int64        `json:"id"`
+	ArticleID   int64        `json:"articleId"`
+	SubmittedBy int64        `json:"submittedBy"`
+	ReviewerID  *int64       `json:"reviewerId"`
+	Status      ReviewStatus `json:"status"`
+	SubmittedAt time.Time    `json:"submittedAt"`
+	ClaimedAt   *time.Time   `json:"claimedAt"`
+	DecidedAt   *time.Time   `json:"decidedAt"`
+}
+
+// ReviewNote is an inline note left by a reviewer on a part of the article content.
+type ReviewNote struct {
+	ID         int64     `json:"id"`
+	ReviewID   int64     `json:"reviewId"`
+	ReviewerID int64     `json:"reviewerId"`
+	Quote      string    `json:"quote"`
+	Start      int       `json:"start"`
+	End        int       `json:"end"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// ReviewDecision is a record of every decision taken on a review.
+type ReviewDecision struct {
+	ID         int64              `json:"id"`
+	ReviewID   int64              `json:"reviewId"`
+	ReviewerID int64              `json:"reviewerId"`
+	Decision   ReviewDecisionType `json:"decision"`
+	Comment    string             `json:"comment"`
+	DecidedAt  time.Time          `json:"decidedAt"`
+}
+
+// ArticleTranslation is the title, subtitle and content of an article in another language.
+type ArticleTranslation struct {
+	ID             int64      `json:"id"`
+	ArticleID      int64      `json:"articleId"`
+	Language       string     `json:"language"`
+	Title          string     `json:"title"`
+	Subtitle       string     `json:"subtitle"`
+	Content        string     `json:"content"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	LastModifiedAt *time.Time `json:"lastModifiedAt"`
+}
+
+// DuplicateKind tells a repost of the same author apart from a copy of someone else's work.
+type DuplicateKind string
+
+const (
+	DuplicateKindSelfRepost DuplicateKind = "SELF_REPOST"
+	DuplicateKindCopy       DuplicateKind = "COPY"
+)
+
+// ArticleFingerprint is the SimHash of an article content.
+type ArticleFingerprint struct {
+	ArticleID   int64     `json:"articleId"`
+	AuthorID    int64     `json:"authorId"`
+	Fingerprint uint64    `json:"fingerprint"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// DuplicateMatch is an article found to be a near-duplicate of an existing one.
+type DuplicateMatch struct {
+	ID               int64         `json:"id"`
+	ArticleID        int64         `json:"articleId"`
+	MatchedArticleID int64         `json:"matchedArticleId"`
+	Distance         int           `json:"distance"`
+	Similarity       float64       `json:"similarity"`
+	Kind             DuplicateKind `json:"kind"`
+	DetectedAt       time.Time     `json:"detectedAt"`
+}
+
+// FeaturedArticle is an article pinned to a homepage slot by an editor.
+type FeaturedArticle struct {
+	ID        int64     `json:"id"`
+	ArticleID int64     `json:"articleId"`
+	Position  int       `json:"position"`
+	PinnedBy  int64     `json:"pinnedBy"`
+	PinnedAt  time.Time `json:"pinnedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// EngagementKind is a type of reader interaction counted towards trending.
+type EngagementKind string
+
+const (
+	EngagementKindView     EngagementKind = "VIEW"
+	EngagementKindReaction EngagementKind = "REACTION"
+	EngagementKindComment  EngagementKind = "COMMENT"
+)
+
+// ArticleEngagement is a single reader interaction with an article.
+type ArticleEngagement struct {
+	ArticleID  int64          `json:"articleId"`
+	Kind       EngagementKind `json:"kind"`
+	OccurredAt time.Time      `json:"occurredAt"`
+}
+
+// EngagementBucket counts the interactions of a kind with an article that are the same number of hours old.
+type EngagementBucket struct {
+	ArticleID int64
+	Kind      EngagementKind
+	AgeHours  int
+	Count     int
+}
+
+// TrendingWindow is the period the trending ranking looks back on.
+type TrendingWindow string
+
+const (
+	TrendingWindowDay  TrendingWindow = "24h"
+	TrendingWindowWeek TrendingWindow = "7d"
+)
+
+// TrendingScore is the decayed engagement score of an article.
+type TrendingScore struct {
+	ArticleID int64
+	Score     float64
+}
+
+// WeeklyPublishCount is how many articles went live in the week starting on Monday.
+type WeeklyPublishCount struct {
+	WeekStart time.Time `json:"weekStart"`
+	Published int       `json:"published"`
+}
+
+// EditedArticle is an article with how many times it was edited.
+type EditedArticle struct {
+	ID             int64         `json:"id"`
+	Title          string        `json:"title"`
+	Status         ArticleStatus `json:"status"`
+	EditCount      int           `json:"editCount"`
+	LastModifiedAt *time.Time    `json:"lastModifiedAt"`
+}
+
+// AuthorSummary is the dashboard statistics of an author's articles.
+type AuthorSummary struct {
+	CountByStatus                map[ArticleStatus]int `json:"countByStatus"`
+	WeeklyPublished              []WeeklyPublishCount  `json:"weeklyPublished"`
+	AverageDraftToPublishSeconds *float64              `json:"averageDraftToPublishSeconds"`
+	MostEdited                   []EditedArticle       `json:"mostEdited"`
+}
+
+// LinkedArticle is one end of a link between the contents of two articles.
+type LinkedArticle struct {
+	ID       int64         `json:"id"`
+	Title    string        `json:"title"`
+	Slug     string        `json:"slug"`
+	Status   ArticleStatus `json:"status"`
+	AuthorID int64         `json:"authorId"`
 }
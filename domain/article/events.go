@@ -0,0 +1,25 @@
+package article
+
+import (
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/event"
+)
+
+// ArticleEvent is the payload of the article events, only the columns touched by the change are set.
+// Status changes carry the previous status too, so a first release can be told apart from a republished article.
+type ArticleEvent struct {
+	ID             int64         `json:"id"`
+	AuthorID       int64         `json:"authorId"`
+	Title          string        `json:"title,omitempty"`
+	Subtitle       string        `json:"subtitle,omitempty"`
+	Status         ArticleStatus `json:"status,omitempty"`
+	PreviousStatus ArticleStatus `json:"previousStatus,omitempty"`
+	PublishedAt    *time.Time    `json:"publishedAt,omitempty"`
+}
+
+// statusEvents are the status changes other services care about.
+var statusEvents = map[ArticleStatus]event.Type{
+	ArticleStatusPublished: event.ArticlePublished,
+	ArticleStatusArchived:  event.ArticleArchived,
+}
@@ -0,0 +1,86 @@
+package article
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+)
+
+// ArticleExpandAuthor embeds the public author profile in article responses.
+const ArticleExpandAuthor = "author"
+
+// requestedExpansions reads `expand=author` as well as repeated or comma separated values.
+func requestedExpansions(r *http.Request) (expand []string) {
+	for _, value := range r.URL.Query()["expand"] {
+		for _, field := range strings.Split(value, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				expand = append(expand, field)
+			}
+		}
+	}
+
+	return
+}
+
+func expands(expand []string, field string) bool {
+	for _, requested := range expand {
+		if requested == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadAuthors fetches the profiles of every distinct author with a single query.
+func loadAuthors(ctx context.Context, accountRepo account.AccountRepository, authorIDs []int64) (profiles map[int64]AuthorProfile, err error) {
+	seen := make(map[int64]bool, len(authorIDs))
+	var IDs []int64
+	for _, ID := range authorIDs {
+		if seen[ID] {
+			continue
+		}
+		seen[ID] = true
+		IDs = append(IDs, ID)
+	}
+
+	accounts, err := accountRepo.FindByIDs(ctx, IDs)
+	if err != nil {
+		return
+	}
+
+	profiles = make(map[int64]AuthorProfile, len(accounts))
+	for _, account := range accounts {
+		profiles[account.ID] = AuthorProfile{
+			ID:        account.ID,
+			Name:      strings.TrimSpace(account.FirstName + " " + account.LastName),
+			Handle:    account.Handle,
+			AvatarURL: account.AvatarURL,
+		}
+	}
+
+	return
+}
+
+// expandAuthors embeds the author profiles into the listing, authors that no longer exist are left out.
+func expandAuthors(ctx context.Context, accountRepo account.AccountRepository, articles []GetArticleResponse) (err error) {
+	authorIDs := make([]int64, len(articles))
+	for i, article := range articles {
+		authorIDs[i] = article.AuthorID
+	}
+
+	profiles, err := loadAuthors(ctx, accountRepo, authorIDs)
+	if err != nil {
+		return
+	}
+
+	for i := range articles {
+		if profile, ok := profiles[articles[i].AuthorID]; ok {
+			articles[i].Author = &profile
+		}
+	}
+
+	return
+}
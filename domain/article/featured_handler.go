@@ -0,0 +1,104 @@
+package article
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type FeaturedHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  FeaturedUsecase
+}
+
+func NewFeaturedHTTPHandler(
+	router *mux.Router,
+	basicAuthMiddleware middleware.RouteMiddleware,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase FeaturedUsecase,
+) {
+	handler := &FeaturedHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/article/featured", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, handler.GetFeatured)).Methods(http.MethodGet)
+	//Put
+	router.HandleFunc("/v1/article/{id:[0-9]+}/pin", bearerAuthMiddleware.VerifyBearer(handler.Pin)).Methods(http.MethodPut)
+	//Delete
+	router.HandleFunc("/v1/article/{id:[0-9]+}/pin", bearerAuthMiddleware.VerifyBearer(handler.Unpin)).Methods(http.MethodDelete)
+}
+
+func (handler *FeaturedHTTPHandler) GetFeatured(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var ctx = r.Context()
+
+	resp = handler.Usecase.GetFeatured(ctx)
+	resp.JSON(w)
+}
+
+func (handler *FeaturedHTTPHandler) Pin(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params PinArticleRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Pin(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *FeaturedHTTPHandler) Unpin(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UnpinArticleRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Unpin(ctx, params)
+	resp.JSON(w)
+}
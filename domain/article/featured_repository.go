@@ -0,0 +1,129 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type FeaturedRepository interface {
+	Pin(ctx context.Context, featured FeaturedArticle) (err error)
+	Unpin(ctx context.Context, articleID int64) (err error)
+	FindActive(ctx context.Context, at time.Time) (featured []FeaturedArticle, err error)
+}
+
+type featuredRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+}
+
+func NewFeaturedRepository(db *sql.DB, tableName string) FeaturedRepository {
+	return &featuredRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+// Pin takes over the slot, both the article and the position are unique so
+// REPLACE drops whatever pinned the article before and whatever held the slot.
+func (r *featuredRepositoryImpl) Pin(ctx context.Context, featured FeaturedArticle) (err error) {
+	command := fmt.Sprintf(`REPLACE INTO %s (articleId, position, pinnedBy, pinnedAt, expiresAt) VALUES (?, ?, ?, ?, ?)`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		featured.ArticleID,
+		featured.Position,
+		featured.PinnedBy,
+		featured.PinnedAt,
+		featured.ExpiresAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (r *featuredRepositoryImpl) Unpin(ctx context.Context, articleID int64) (err error) {
+	command := fmt.Sprintf(`DELETE FROM %s WHERE articleId = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, articleID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *featuredRepositoryImpl) FindActive(ctx context.Context, at time.Time) (featured []FeaturedArticle, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, position, pinnedBy, pinnedAt, expiresAt FROM %s WHERE expiresAt > ? ORDER BY position ASC`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, at)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		pin := FeaturedArticle{}
+
+		err = rows.Scan(
+			&pin.ID,
+			&pin.ArticleID,
+			&pin.Position,
+			&pin.PinnedBy,
+			&pin.PinnedAt,
+			&pin.ExpiresAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		featured = append(featured, pin)
+	}
+
+	return
+}
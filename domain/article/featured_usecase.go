@@ -0,0 +1,178 @@
+package article
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type FeaturedUsecase interface {
+	GetFeatured(ctx context.Context) (resp response.Response)
+	Pin(ctx context.Context, params PinArticleRequest) (resp response.Response)
+	Unpin(ctx context.Context, params UnpinArticleRequest) (resp response.Response)
+}
+
+type featuredUsecaseImpl struct {
+	location    *time.Location
+	repository  FeaturedRepository
+	articleRepo ArticleRepository
+	accountRepo account.AccountRepository
+}
+
+func NewFeaturedUsecase(
+	location *time.Location,
+	repository FeaturedRepository,
+	articleRepo ArticleRepository,
+	accountRepo account.AccountRepository,
+) FeaturedUsecase {
+	return &featuredUsecaseImpl{
+		location:    location,
+		repository:  repository,
+		articleRepo: articleRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+func (u *featuredUsecaseImpl) findCurator(ctx context.Context) (account entity.Account, resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return account, response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return account, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if !account.HasRole(entity.AccountRoleEditor, entity.AccountRoleAdmin) {
+		return account, response.Error(response.StatusForbiddend, nil, exception.ErrUnauthorized)
+	}
+
+	return account, nil
+}
+
+func (u *featuredUsecaseImpl) GetFeatured(ctx context.Context) (resp response.Response) {
+	pins, err := u.repository.FindActive(ctx, time.Now().In(u.location))
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	articles, err := u.articleRepo.FindManyByStatus(ctx, ArticleStatusPublished)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	published := make(map[int64]Article, len(articles))
+	for _, element := range articles {
+		published[element.ID] = element
+	}
+
+	arr := []FeaturedArticleResponse{}
+	pinned := make(map[int64]bool)
+
+	//Pins of articles that went offline since are skipped
+	for _, pin := range pins {
+		element, ok := published[pin.ArticleID]
+		if !ok {
+			continue
+		}
+
+		m := u.toResponse(ctx, element)
+		m.Pinned = true
+		m.Position = pin.Position
+		expiresAt := pin.ExpiresAt
+		m.ExpiresAt = &expiresAt
+
+		arr = append(arr, m)
+		pinned[pin.ArticleID] = true
+	}
+
+	for _, element := range articles {
+		if pinned[element.ID] {
+			continue
+		}
+
+		arr = append(arr, u.toResponse(ctx, element))
+	}
+
+	return response.Success(response.StatusOK, arr)
+}
+
+func (u *featuredUsecaseImpl) toResponse(ctx context.Context, element Article) (m FeaturedArticleResponse) {
+	restrict(ctx, &element)
+
+	m.ID = element.ID
+	m.Title = element.Title
+	m.Subtitle = element.Subtitle
+	m.Content = element.Content
+	m.Language = element.Language
+	m.AccessLevel = element.AccessLevel
+	m.Locked = element.Locked
+	m.Status = element.Status
+	m.CreatedAt = element.CreatedAt
+	m.PublishedAt = element.PublishedAt
+	m.LastModifiedAt = element.LastModifiedAt
+	m.AuthorID = element.Author.ID
+	m.Slug = element.Slug
+
+	return
+}
+
+func (u *featuredUsecaseImpl) Pin(ctx context.Context, params PinArticleRequest) (resp response.Response) {
+	curator, resp := u.findCurator(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	article, err := u.articleRepo.FindByID(ctx, params.ArticleID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	//Only what readers can already find on the listing can be featured
+	if article.Status != ArticleStatusPublished {
+		return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+	}
+
+	pinnedAt := time.Now().In(u.location)
+	if !params.ExpiresAt.After(pinnedAt) {
+		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+	}
+
+	featured := FeaturedArticle{}
+	featured.ArticleID = article.ID
+	featured.Position = params.Position
+	featured.PinnedBy = curator.ID
+	featured.PinnedAt = pinnedAt
+	featured.ExpiresAt = params.ExpiresAt.In(u.location)
+
+	err = u.repository.Pin(ctx, featured)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, featured)
+}
+
+func (u *featuredUsecaseImpl) Unpin(ctx context.Context, params UnpinArticleRequest) (resp response.Response) {
+	_, resp = u.findCurator(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	err := u.repository.Unpin(ctx, params.ArticleID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
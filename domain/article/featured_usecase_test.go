@@ -0,0 +1,97 @@
+package article_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+func TestFeaturedUsecaseGetFeatured_PinnedFirst(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindManyByStatus", mock.Anything, article.ArticleStatusPublished).Return([]article.Article{
+		{ID: 1, Status: article.ArticleStatusPublished},
+		{ID: 2, Status: article.ArticleStatusPublished},
+		{ID: 3, Status: article.ArticleStatusPublished},
+	}, nil)
+
+	featuredRepo := new(articleMocks.FeaturedRepository)
+	featuredRepo.On("FindActive", mock.Anything, mock.AnythingOfType("time.Time")).Return([]article.FeaturedArticle{
+		{ArticleID: 3, Position: 1, ExpiresAt: time.Now().Add(time.Hour)},
+		{ArticleID: 9, Position: 2, ExpiresAt: time.Now().Add(time.Hour)},
+	}, nil)
+
+	u := article.NewFeaturedUsecase(location, featuredRepo, articleRepo, accountRepo)
+
+	resp := u.GetFeatured(context.Background())
+	assert.NoError(t, resp.Err())
+
+	recorder := httptest.NewRecorder()
+	resp.JSON(recorder)
+
+	var body struct {
+		Data []article.FeaturedArticleResponse `json:"data"`
+	}
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+	assert.Len(t, body.Data, 3)
+	assert.Equal(t, int64(3), body.Data[0].ID)
+	assert.True(t, body.Data[0].Pinned)
+	assert.False(t, body.Data[1].Pinned)
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	featuredRepo.AssertExpectations(t)
+}
+
+func TestFeaturedUsecasePin_Success(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(dataEditor, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusPublished}, nil)
+
+	featuredRepo := new(articleMocks.FeaturedRepository)
+	featuredRepo.On("Pin", mock.Anything, mock.MatchedBy(func(featured article.FeaturedArticle) bool {
+		return featured.ArticleID == 1 && featured.Position == 2 && featured.PinnedBy == dataEditor.ID
+	})).Return(nil)
+
+	u := article.NewFeaturedUsecase(location, featuredRepo, articleRepo, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, dataEditor.Email)
+
+	resp := u.Pin(ctx, article.PinArticleRequest{ArticleID: 1, Position: 2, ExpiresAt: time.Now().Add(time.Hour * 24)})
+	assert.NoError(t, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	featuredRepo.AssertExpectations(t)
+}
+
+func TestFeaturedUsecasePin_NotEditor(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 3, Role: entity.AccountRoleAuthor}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	featuredRepo := new(articleMocks.FeaturedRepository)
+
+	u := article.NewFeaturedUsecase(location, featuredRepo, articleRepo, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "author@mail.c")
+
+	resp := u.Pin(ctx, article.PinArticleRequest{ArticleID: 1, Position: 1, ExpiresAt: time.Now().Add(time.Hour)})
+	assert.Equal(t, exception.ErrUnauthorized, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	featuredRepo.AssertExpectations(t)
+}
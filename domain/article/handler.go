@@ -12,8 +12,9 @@ import (
 )
 
 type ArticleHTTPHandler struct {
-	Validate *validator.Validate
-	Usecase  ArticleUsecase
+	Validate   *validator.Validate
+	Usecase    ArticleUsecase
+	Negotiator *response.Negotiator
 }
 
 func NewArticleHTTPHandler(
@@ -24,14 +25,17 @@ func NewArticleHTTPHandler(
 	usecase ArticleUsecase,
 ) {
 	handler := &ArticleHTTPHandler{
-		Validate: validate,
-		Usecase:  usecase,
+		Validate:   validate,
+		Usecase:    usecase,
+		Negotiator: response.NewNegotiator(ArticleRenderers()...),
 	}
 
 	//Get
-	router.HandleFunc("/v1/article/all", basicAuthMiddleware.Verify(handler.GetAllPublic)).Methods(http.MethodGet)
-	router.HandleFunc("/v1/article/my-articles", bearerAuthMiddleware.VerifyBearer(handler.GetAllPrivate)).Methods(http.MethodGet)
-	router.HandleFunc("/v1/article/{id:[0-9]+}", bearerAuthMiddleware.VerifyBearer(handler.GetOne)).Methods(http.MethodGet)
+	// Public routes accept a bearer token so members get the full content.
+	router.HandleFunc("/v1/article/all", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, middleware.Conditional(middleware.CacheControlPublic, handler.GetAllPublic))).Methods(http.MethodGet)
+	router.HandleFunc("/v1/article/my-articles", bearerAuthMiddleware.VerifyBearer(middleware.Conditional(middleware.CacheControlPrivate, handler.GetAllPrivate))).Methods(http.MethodGet)
+	router.HandleFunc("/v1/article/my-articles/summary", bearerAuthMiddleware.VerifyBearer(middleware.Conditional(middleware.CacheControlPrivate, handler.GetSummary))).Methods(http.MethodGet)
+	router.HandleFunc("/v1/article/{id:[0-9]+}", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, middleware.Conditional(middleware.CacheControlPublic, handler.GetOne))).Methods(http.MethodGet)
 	//Post
 	router.HandleFunc("/v1/article", bearerAuthMiddleware.VerifyBearer(handler.Create)).Methods(http.MethodPost)
 	//Put
@@ -97,17 +101,65 @@ func (handler *ArticleHTTPHandler) Edit(w http.ResponseWriter, r *http.Request)
 
 func (handler *ArticleHTTPHandler) GetAllPublic(w http.ResponseWriter, r *http.Request) {
 	var resp response.Response
+	var params ListArticleRequest
 	var ctx = r.Context()
 
-	resp = handler.Usecase.GetAllPublic(ctx)
+	params.Languages = requestedLanguages(r)
+	params.Expand = requestedExpansions(r)
+
+	err := handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = response.WithFields(handler.Usecase.GetAllPublic(ctx, params), response.RequestedFields(r))
 	resp.JSON(w)
 }
 
 func (handler *ArticleHTTPHandler) GetAllPrivate(w http.ResponseWriter, r *http.Request) {
 	var resp response.Response
+	var params ListArticleRequest
 	var ctx = r.Context()
 
-	resp = handler.Usecase.GetAllPrivate(ctx)
+	params.Languages = requestedLanguages(r)
+	params.Expand = requestedExpansions(r)
+
+	err := handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = response.WithFields(handler.Usecase.GetAllPrivate(ctx, params), response.RequestedFields(r))
+	resp.JSON(w)
+}
+
+func (handler *ArticleHTTPHandler) GetSummary(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params ArticleSummaryRequest
+	var ctx = r.Context()
+	var err error
+
+	if weeks := r.URL.Query().Get("weeks"); weeks != "" {
+		params.Weeks, err = strconv.Atoi(weeks)
+		if err != nil {
+			resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+			resp.JSON(w)
+			return
+		}
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetSummary(ctx, params)
 	resp.JSON(w)
 }
 
@@ -158,6 +210,8 @@ func (handler *ArticleHTTPHandler) GetOne(w http.ResponseWriter, r *http.Request
 	}
 
 	params.ID = convertedID
+	params.Languages = requestedLanguages(r)
+	params.Expand = requestedExpansions(r)
 
 	err = handler.Validate.StructCtx(ctx, params)
 	if err != nil {
@@ -166,6 +220,6 @@ func (handler *ArticleHTTPHandler) GetOne(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	resp = handler.Usecase.GetOne(ctx, params)
-	resp.JSON(w)
+	resp = response.WithFields(handler.Usecase.GetOne(ctx, params), response.RequestedFields(r))
+	handler.Negotiator.Write(w, r, resp)
 }
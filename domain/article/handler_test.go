@@ -133,3 +133,121 @@ func TestHandlerEdit_Success(t *testing.T) {
 
 	articleUsecase.AssertExpectations(t)
 }
+
+func TestHandlerGetAllPublic_SparseFieldset(t *testing.T) {
+	publishedAt := time.Now()
+	articles := []article.GetArticleResponse{
+		{ID: 1, Title: "first", Content: "first content", Status: article.ArticleStatusPublished, PublishedAt: &publishedAt},
+		{ID: 2, Title: "second", Content: "second content", Status: article.ArticleStatusPublished, PublishedAt: &publishedAt},
+	}
+
+	articleUsecase := new(mocks.ArticleUsecase)
+	articleUsecase.On("GetAllPublic", mock.Anything, mock.AnythingOfType("article.ListArticleRequest")).Return(response.Success(response.StatusOK, articles))
+
+	articleHTTPHandler := article.ArticleHTTPHandler{
+		Validate: validator.New(),
+		Usecase:  articleUsecase,
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/all?fields=id,title,publishedAt", nil)
+	recorder := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(articleHTTPHandler.GetAllPublic)
+	handler.ServeHTTP(recorder, r)
+
+	rb := struct {
+		Data []map[string]interface{} `json:"data"`
+	}{}
+	if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+		t.Error(err)
+		return
+	}
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Len(t, rb.Data, 2)
+	assert.Len(t, rb.Data[0], 3, "only the requested fields should be returned")
+	assert.Equal(t, "first", rb.Data[0]["title"])
+	assert.NotContains(t, rb.Data[0], "content")
+
+	articleUsecase.AssertExpectations(t)
+}
+
+func TestHandlerGetAllPublic_UnknownField(t *testing.T) {
+	articleUsecase := new(mocks.ArticleUsecase)
+	articleUsecase.On("GetAllPublic", mock.Anything, mock.AnythingOfType("article.ListArticleRequest")).Return(response.Success(response.StatusOK, []article.GetArticleResponse{{ID: 1}}))
+
+	articleHTTPHandler := article.ArticleHTTPHandler{
+		Validate: validator.New(),
+		Usecase:  articleUsecase,
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/all?fields=id,password", nil)
+	recorder := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(articleHTTPHandler.GetAllPublic)
+	handler.ServeHTTP(recorder, r)
+
+	rb := responseBody{}
+	if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+		t.Error(err)
+		return
+	}
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, response.StatusInvalidPayload, rb.Status)
+
+	articleUsecase.AssertExpectations(t)
+}
+
+func TestHandlerGetOne_ContentNegotiation(t *testing.T) {
+	publishedAt := time.Date(2021, time.October, 1, 8, 0, 0, 0, time.UTC)
+	existing := article.Article{
+		ID:          1,
+		Title:       `Belajar "Go"`,
+		Subtitle:    "Dari nol",
+		Content:     "Paragraf pertama.\n\nParagraf <kedua>.",
+		Language:    "id",
+		Status:      article.ArticleStatusPublished,
+		PublishedAt: &publishedAt,
+	}
+
+	testCases := []struct {
+		accept      string
+		statusCode  int
+		contentType string
+		contains    []string
+	}{
+		{"", http.StatusOK, "application/json", []string{`"title":"Belajar \"Go\""`}},
+		{"text/markdown", http.StatusOK, "text/markdown; charset=utf-8", []string{"---\nid: 1\ntitle: \"Belajar \\\"Go\\\"\"\n", "# Belajar \"Go\"", "Paragraf <kedua>."}},
+		{"text/html, application/json;q=0.5", http.StatusOK, "text/html; charset=utf-8", []string{"<!DOCTYPE html>", "<title>Belajar &#34;Go&#34;</title>", "<p>Paragraf &lt;kedua&gt;.</p>"}},
+		{"text/plain", http.StatusOK, "text/plain; charset=utf-8", []string{"Belajar \"Go\"\nDari nol\n\nParagraf pertama."}},
+		{"application/pdf", http.StatusNotAcceptable, "", nil},
+	}
+
+	for _, tc := range testCases {
+		articleUsecase := new(mocks.ArticleUsecase)
+		articleUsecase.On("GetOne", mock.Anything, mock.AnythingOfType("article.GetOneArticleRequest")).Return(response.Success(response.StatusOK, existing))
+
+		articleHTTPHandler := article.ArticleHTTPHandler{
+			Validate:   validator.New(),
+			Usecase:    articleUsecase,
+			Negotiator: response.NewNegotiator(article.ArticleRenderers()...),
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+		r.Header.Set("Accept", tc.accept)
+		r = mux.SetURLVars(r, map[string]string{"id": "1"})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(articleHTTPHandler.GetOne)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, tc.statusCode, recorder.Code, tc.accept)
+		if tc.contentType != "" {
+			assert.Equal(t, tc.contentType, recorder.Header().Get("Content-Type"), tc.accept)
+		}
+		for _, expected := range tc.contains {
+			assert.Contains(t, recorder.Body.String(), expected, tc.accept)
+		}
+	}
+}
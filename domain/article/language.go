@@ -0,0 +1,125 @@
+package article
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// normalizeLanguage turns a language tag such as `en_US` or `EN-us` into `en-us`.
+func normalizeLanguage(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// baseLanguage returns the primary subtag, `en` for `en-us`.
+func baseLanguage(tag string) string {
+	if i := strings.Index(tag, "-"); i > 0 {
+		return tag[:i]
+	}
+
+	return tag
+}
+
+// requestedLanguages returns the languages asked by the client ordered by preference.
+// The `lang` query parameter wins over the `Accept-Language` header.
+func requestedLanguages(r *http.Request) (languages []string) {
+	if lang := normalizeLanguage(r.URL.Query().Get("lang")); lang != "" {
+		return []string{lang}
+	}
+
+	return parseAcceptLanguage(r.Header.Get("Accept-Language"))
+}
+
+// parseAcceptLanguage parses an `Accept-Language` header value, dropping wildcards and refused languages.
+func parseAcceptLanguage(header string) (languages []string) {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var candidates []weighted
+
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := normalizeLanguage(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, field := range fields[1:] {
+			field = strings.TrimSpace(field)
+			if strings.HasPrefix(field, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(field, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, weighted{tag, quality})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	for _, candidate := range candidates {
+		languages = append(languages, candidate.tag)
+	}
+
+	return
+}
+
+// expandLanguages adds the base language after each regional preference, `en-us` is followed by `en`.
+func expandLanguages(languages []string) (expanded []string) {
+	seen := make(map[string]bool)
+
+	add := func(tag string) {
+		if !seen[tag] {
+			seen[tag] = true
+			expanded = append(expanded, tag)
+		}
+	}
+
+	for _, tag := range languages {
+		add(tag)
+		add(baseLanguage(tag))
+	}
+
+	return
+}
+
+// pickTranslation returns the best translation for the preferences, false means the original should be served.
+func pickTranslation(original string, languages []string, translations []ArticleTranslation) (translation ArticleTranslation, ok bool) {
+	for _, tag := range expandLanguages(languages) {
+		if tag == original {
+			return
+		}
+
+		for _, translation := range translations {
+			if translation.Language == tag {
+				return translation, true
+			}
+		}
+	}
+
+	return
+}
+
+// localize replaces the texts of the article with the best translation for the preferences.
+func localize(article *Article, languages []string, translations []ArticleTranslation) {
+	translation, ok := pickTranslation(normalizeLanguage(article.Language), languages, translations)
+	if !ok {
+		return
+	}
+
+	article.Title = translation.Title
+	article.Subtitle = translation.Subtitle
+	article.Content = translation.Content
+	article.Language = translation.Language
+}
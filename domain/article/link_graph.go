@@ -0,0 +1,206 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// slugMaxLength keeps slugs of long titles readable in a URL.
+const slugMaxLength = 80
+
+var (
+	absoluteLinkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"'()\[\]]+`)
+	relativeLinkPattern = regexp.MustCompile(`(?m)(?:^|[\s("'=])(/(?:v1/)?article/[^\s<>"'()\[\]]+)`)
+	articlePathPattern  = regexp.MustCompile(`^/(?:v1/)?article/([A-Za-z0-9-]+)/?$`)
+	articleIDPattern    = regexp.MustCompile(`^([0-9]+)(?:-[A-Za-z0-9-]*)?$`)
+)
+
+// reservedArticlePaths are routes under /article that are not articles.
+var reservedArticlePaths = map[string]bool{
+	"all":         true,
+	"featured":    true,
+	"my-articles": true,
+	"status":      true,
+	"trending":    true,
+}
+
+// slugify turns a title into the lowercase, dash separated slug articles can be linked by.
+func slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if b.Len() > 0 && !dash {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	slug := strings.TrimRight(b.String(), "-")
+	if len(slug) > slugMaxLength {
+		slug = strings.TrimRight(slug[:slugMaxLength], "-")
+	}
+
+	return slug
+}
+
+// LinkGraph keeps the links between articles found in their content.
+type LinkGraph struct {
+	hosts      map[string]bool
+	repository ArticleLinkRepository
+}
+
+// NewLinkGraph recognizes relative article links and absolute ones pointing at the base URL host.
+func NewLinkGraph(baseURL string, repository ArticleLinkRepository) *LinkGraph {
+	hosts := make(map[string]bool)
+	if parsed, err := url.Parse(baseURL); err == nil && parsed.Host != "" {
+		hosts[strings.ToLower(parsed.Host)] = true
+	}
+
+	return &LinkGraph{
+		hosts:      hosts,
+		repository: repository,
+	}
+}
+
+// Register makes every status change warn about links to articles readers cannot open.
+func (g *LinkGraph) Register(m *ArticleStateMachine) {
+	for _, status := range articleStatuses {
+		m.AfterEnter(status, g.checkTransition)
+	}
+}
+
+func (g *LinkGraph) checkTransition(ctx context.Context, transition *StatusTransition) (err error) {
+	targets, err := g.repository.FindTargets(ctx, transition.Article.ID)
+	if err != nil {
+		return
+	}
+
+	transition.Warnings = append(transition.Warnings, linkWarnings(targets)...)
+
+	return
+}
+
+// References lists the articles the content links to, by ID or by slug.
+// A path starting with digits is read as an ID, so `/article/12-some-title` links to article 12.
+func (g *LinkGraph) References(content string) (IDs []int64, slugs []string) {
+	var paths []string
+	for _, link := range absoluteLinkPattern.FindAllString(content, -1) {
+		parsed, err := url.Parse(link)
+		if err != nil || !g.hosts[strings.ToLower(parsed.Host)] {
+			continue
+		}
+		paths = append(paths, parsed.Path)
+	}
+	for _, match := range relativeLinkPattern.FindAllStringSubmatch(content, -1) {
+		parsed, err := url.Parse(match[1])
+		if err != nil {
+			continue
+		}
+		paths = append(paths, parsed.Path)
+	}
+
+	seenIDs := make(map[int64]bool)
+	seenSlugs := make(map[string]bool)
+	for _, path := range paths {
+		match := articlePathPattern.FindStringSubmatch(path)
+		if match == nil {
+			continue
+		}
+
+		segment := strings.ToLower(match[1])
+		if idMatch := articleIDPattern.FindStringSubmatch(segment); idMatch != nil {
+			ID, err := strconv.ParseInt(idMatch[1], 10, 64)
+			if err == nil && !seenIDs[ID] {
+				seenIDs[ID] = true
+				IDs = append(IDs, ID)
+			}
+			continue
+		}
+
+		if !reservedArticlePaths[segment] && !seenSlugs[segment] {
+			seenSlugs[segment] = true
+			slugs = append(slugs, segment)
+		}
+	}
+
+	return
+}
+
+// Index replaces the stored links of the article with the ones in its content and warns about broken ones.
+// The article is already saved when it is called, so a failure is logged and leaves the previous links.
+func (g *LinkGraph) Index(ctx context.Context, article Article) (warnings []string) {
+	IDs, slugs := g.References(article.Content)
+
+	if len(slugs) > 0 {
+		resolved, err := g.repository.FindIDsBySlugs(ctx, slugs)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		for _, slug := range slugs {
+			ID, ok := resolved[slug]
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("links to article %q which does not exist", slug))
+				continue
+			}
+			IDs = append(IDs, ID)
+		}
+	}
+
+	targetIDs := make([]int64, 0, len(IDs))
+	seen := map[int64]bool{article.ID: true}
+	for _, ID := range IDs {
+		if !seen[ID] {
+			seen[ID] = true
+			targetIDs = append(targetIDs, ID)
+		}
+	}
+
+	if err := g.repository.ReplaceLinks(ctx, article.ID, targetIDs); err != nil {
+		log.Println(err)
+		return
+	}
+
+	if len(targetIDs) == 0 {
+		return
+	}
+
+	targets, err := g.repository.FindTargets(ctx, article.ID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	found := make(map[int64]bool)
+	for _, target := range targets {
+		found[target.ID] = true
+	}
+	for _, ID := range targetIDs {
+		if !found[ID] {
+			warnings = append(warnings, fmt.Sprintf("links to article %d which does not exist", ID))
+		}
+	}
+
+	return append(warnings, linkWarnings(targets)...)
+}
+
+// linkWarnings names the linked articles readers cannot open.
+func linkWarnings(targets []LinkedArticle) (warnings []string) {
+	for _, target := range targets {
+		if target.Status.IsUnreleased() || target.Status == ArticleStatusArchived {
+			warnings = append(warnings, fmt.Sprintf("links to %s article %d %q", target.Status, target.ID, target.Title))
+		}
+	}
+
+	return
+}
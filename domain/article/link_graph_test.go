@@ -0,0 +1,70 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+)
+
+func TestLinkGraphReferences(t *testing.T) {
+	g := article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository))
+
+	content := `See http://localhost:9001/v1/article/2 and [the sequel](/article/3-the-sequel).
+Also <a href="/v1/article/go-concurrency-patterns">this one</a>, http://localhost:9001/v1/article/2?ref=home again,
+the list at /v1/article/all, the picks at /v1/article/featured and /article/trending,
+and https://example.com/v1/article/4 which lives elsewhere.`
+
+	IDs, slugs := g.References(content)
+	assert.Equal(t, []int64{2, 3}, IDs)
+	assert.Equal(t, []string{"go-concurrency-patterns"}, slugs)
+}
+
+func TestLinkGraphIndex_Warnings(t *testing.T) {
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("FindIDsBySlugs", mock.Anything, []string{"draft-article", "missing-article"}).Return(map[string]int64{"draft-article": 3}, nil)
+	linkRepo.On("ReplaceLinks", mock.Anything, int64(1), []int64{2, 5, 3}).Return(nil)
+	linkRepo.On("FindTargets", mock.Anything, int64(1)).Return([]article.LinkedArticle{
+		{ID: 2, Title: "Published", Status: article.ArticleStatusPublished},
+		{ID: 3, Title: "Draft", Status: article.ArticleStatusDraft},
+	}, nil)
+
+	g := article.NewLinkGraph(baseURL, linkRepo)
+
+	content := "/article/1 /article/2 /article/5 /article/draft-article /article/missing-article"
+	warnings := g.Index(context.TODO(), article.Article{ID: 1, Content: content})
+	assert.Equal(t, []string{
+		`links to article "missing-article" which does not exist`,
+		`links to article 5 which does not exist`,
+		`links to DRAFT article 3 "Draft"`,
+	}, warnings)
+
+	linkRepo.AssertExpectations(t)
+}
+
+func TestLinkGraphRegister_WarnsOnTransition(t *testing.T) {
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("FindTargets", mock.Anything, int64(1)).Return([]article.LinkedArticle{
+		{ID: 2, Title: "Archived", Status: article.ArticleStatusArchived},
+	}, nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewLinkGraph(baseURL, linkRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusDraft,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 1, Status: article.ArticleStatusDraft},
+	}
+	err := m.Apply(context.TODO(), transition)
+	assert.NoError(t, err)
+
+	m.Complete(context.TODO(), transition)
+	assert.Equal(t, []string{`links to ARCHIVED article 2 "Archived"`}, transition.Warnings)
+
+	linkRepo.AssertExpectations(t)
+}
@@ -0,0 +1,178 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type ArticleLinkRepository interface {
+	FindIDsBySlugs(ctx context.Context, slugs []string) (IDs map[string]int64, err error)
+	ReplaceLinks(ctx context.Context, sourceID int64, targetIDs []int64) (err error)
+	FindTargets(ctx context.Context, sourceID int64) (targets []LinkedArticle, err error)
+	FindBacklinks(ctx context.Context, targetID int64, status ArticleStatus) (sources []LinkedArticle, err error)
+}
+
+type articleLinkRepositoryImpl struct {
+	db               *sql.DB
+	tableName        string
+	articleTableName string
+}
+
+func NewArticleLinkRepository(db *sql.DB, tableName, articleTableName string) ArticleLinkRepository {
+	return &articleLinkRepositoryImpl{
+		db:               db,
+		tableName:        tableName,
+		articleTableName: articleTableName,
+	}
+}
+
+// FindIDsBySlugs resolves slugs to article IDs, a slug shared by several articles resolves to the oldest one.
+func (r *articleLinkRepositoryImpl) FindIDsBySlugs(ctx context.Context, slugs []string) (IDs map[string]int64, err error) {
+	IDs = make(map[string]int64)
+	if len(slugs) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(slugs)), ", ")
+	query := fmt.Sprintf(`SELECT slug, MIN(id) FROM %s WHERE slug IN (%s) GROUP BY slug`, r.articleTableName, placeholders)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	args := make([]interface{}, len(slugs))
+	for i, slug := range slugs {
+		args[i] = slug
+	}
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var slug string
+		var ID int64
+
+		if err = rows.Scan(&slug, &ID); err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		IDs[slug] = ID
+	}
+
+	return
+}
+
+// ReplaceLinks swaps the links of the source article, links to articles that do not exist are not kept.
+func (r *articleLinkRepositoryImpl) ReplaceLinks(ctx context.Context, sourceID int64, targetIDs []int64) (err error) {
+	tx, err := database.Begin(ctx, r.db)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer tx.Rollback()
+
+	command := fmt.Sprintf(`DELETE FROM %s WHERE sourceId = ?`, r.tableName)
+	_, err = tx.ExecContext(ctx, command, sourceID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	if len(targetIDs) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(targetIDs)), ", ")
+		command = fmt.Sprintf(`INSERT INTO %s (sourceId, targetId) SELECT ?, id FROM %s WHERE id IN (%s)`, r.tableName, r.articleTableName, placeholders)
+
+		args := make([]interface{}, 0, len(targetIDs)+1)
+		args = append(args, sourceID)
+		for _, ID := range targetIDs {
+			args = append(args, ID)
+		}
+
+		_, err = tx.ExecContext(ctx, command, args...)
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
+	return
+}
+
+func (r *articleLinkRepositoryImpl) FindTargets(ctx context.Context, sourceID int64) (targets []LinkedArticle, err error) {
+	query := fmt.Sprintf(`SELECT a.id, a.title, a.slug, a.status, a.authorId FROM %s l JOIN %s a ON a.id = l.targetId WHERE l.sourceId = ? ORDER BY a.id`, r.tableName, r.articleTableName)
+
+	return r.query(ctx, query, sourceID)
+}
+
+func (r *articleLinkRepositoryImpl) FindBacklinks(ctx context.Context, targetID int64, status ArticleStatus) (sources []LinkedArticle, err error) {
+	query := fmt.Sprintf(`SELECT a.id, a.title, a.slug, a.status, a.authorId FROM %s l JOIN %s a ON a.id = l.sourceId WHERE l.targetId = ? AND a.status = ? ORDER BY a.publishedAt DESC, a.id DESC`, r.tableName, r.articleTableName)
+
+	return r.query(ctx, query, targetID, status)
+}
+
+func (r *articleLinkRepositoryImpl) query(ctx context.Context, query string, args ...interface{}) (linked []LinkedArticle, err error) {
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		article := LinkedArticle{}
+
+		err = rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Slug,
+			&article.Status,
+			&article.AuthorID,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		linked = append(linked, article)
+	}
+
+	return
+}
@@ -0,0 +1,105 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+)
+
+var (
+	// mentionPattern finds `@handle` not glued to a word, so email addresses and paths are left alone.
+	// A mention already rendered as `[@handle](...)` is preceded by a bracket and does not match again.
+	mentionPattern         = regexp.MustCompile(`(^|[^\w@./\[-])@([A-Za-z0-9_]{1,50})\b`)
+	renderedMentionPattern = regexp.MustCompile(`\[@([A-Za-z0-9_]{1,50})\]\(`)
+)
+
+// Mentions lists the handles mentioned in the content, whether already rendered as profile links or not.
+func Mentions(content string) (handles []string) {
+	seen := make(map[string]bool)
+	add := func(handle string) {
+		handle = strings.ToLower(handle)
+		if !seen[handle] {
+			seen[handle] = true
+			handles = append(handles, handle)
+		}
+	}
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		add(match[2])
+	}
+	for _, match := range renderedMentionPattern.FindAllStringSubmatch(content, -1) {
+		add(match[1])
+	}
+
+	return
+}
+
+// MentionWorkflow turns the mentions of an article into profile links when it is published.
+type MentionWorkflow struct {
+	baseURL     string
+	accountRepo account.AccountRepository
+}
+
+// NewMentionWorkflow is a constructor, profile links are made absolute with the base URL.
+func NewMentionWorkflow(baseURL string, accountRepo account.AccountRepository) *MentionWorkflow {
+	return &MentionWorkflow{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		accountRepo: accountRepo,
+	}
+}
+
+// Register renders the mentions whenever an article is published.
+func (w *MentionWorkflow) Register(m *ArticleStateMachine) {
+	m.OnEnter(ArticleStatusPublished, w.render)
+}
+
+func (w *MentionWorkflow) render(ctx context.Context, transition *StatusTransition) (err error) {
+	var handles []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(transition.Article.Content, -1) {
+		if handle := strings.ToLower(match[2]); !seen[handle] {
+			seen[handle] = true
+			handles = append(handles, handle)
+		}
+	}
+
+	if len(handles) == 0 {
+		return
+	}
+
+	accounts, err := w.accountRepo.FindByHandles(ctx, handles)
+	if err != nil {
+		return
+	}
+
+	mentioned := make(map[string]entity.Account)
+	for _, account := range accounts {
+		mentioned[strings.ToLower(account.Handle)] = account
+	}
+
+	for _, handle := range handles {
+		if _, ok := mentioned[handle]; !ok {
+			transition.Warnings = append(transition.Warnings, fmt.Sprintf("mentions @%s which is not an account that can be mentioned", handle))
+		}
+	}
+
+	if len(mentioned) == 0 {
+		return
+	}
+
+	transition.Updated.Content = mentionPattern.ReplaceAllStringFunc(transition.Article.Content, func(match string) string {
+		groups := mentionPattern.FindStringSubmatch(match)
+		account, ok := mentioned[strings.ToLower(groups[2])]
+		if !ok {
+			return match
+		}
+
+		return fmt.Sprintf("%s[@%s](%s/v1/accounts/%d)", groups[1], groups[2], w.baseURL, account.ID)
+	})
+
+	return
+}
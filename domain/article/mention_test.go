@@ -0,0 +1,64 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+)
+
+func TestMentions(t *testing.T) {
+	content := "Thanks @Jane and [@john_doe](http://localhost:9001/v1/accounts/3), mail me at jim@devoria.id or ping @jane again."
+
+	assert.Equal(t, []string{"jane", "john_doe"}, article.Mentions(content))
+}
+
+func TestMentionWorkflow_RendersOnPublish(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByHandles", mock.Anything, []string{"jane", "ghost"}).Return([]entity.Account{
+		{ID: 2, Handle: "jane"},
+	}, nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewMentionWorkflow(baseURL, accountRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From: article.ArticleStatusDraft,
+		To:   article.ArticleStatusPublished,
+		Article: article.Article{
+			ID:      1,
+			Status:  article.ArticleStatusDraft,
+			Content: "Reviewed by @Jane and @ghost, already linked [@john](http://localhost:9001/v1/accounts/3).",
+		},
+	}
+	err := m.Apply(context.TODO(), transition)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Reviewed by [@Jane](http://localhost:9001/v1/accounts/2) and @ghost, already linked [@john](http://localhost:9001/v1/accounts/3).", transition.Updated.Content)
+	assert.Equal(t, []string{"mentions @ghost which is not an account that can be mentioned"}, transition.Warnings)
+
+	accountRepo.AssertExpectations(t)
+}
+
+func TestMentionWorkflow_NoMentions(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+
+	m := article.NewArticleStateMachine()
+	article.NewMentionWorkflow(baseURL, accountRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusDraft,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 1, Status: article.ArticleStatusDraft, Content: "write to jim@devoria.id"},
+	}
+	err := m.Apply(context.TODO(), transition)
+	assert.NoError(t, err)
+	assert.Empty(t, transition.Updated.Content)
+
+	accountRepo.AssertNotCalled(t, "FindByHandles", mock.Anything, mock.Anything)
+}
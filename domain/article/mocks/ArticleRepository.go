@@ -8,6 +8,8 @@ import (
 	article "github.com/sangianpatrick/devoria-article-service/domain/article"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ArticleRepository is an autogenerated mock type for the ArticleRepository type
@@ -36,6 +38,29 @@ func (_m *ArticleRepository) FindByID(ctx context.Context, ID int64) (article.Ar
 	return r0, r1
 }
 
+// FindByIDs provides a mock function with given fields: ctx, IDs
+func (_m *ArticleRepository) FindByIDs(ctx context.Context, IDs []int64) ([]article.Article, error) {
+	ret := _m.Called(ctx, IDs)
+
+	var r0 []article.Article
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []article.Article); ok {
+		r0 = rf(ctx, IDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]article.Article)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, IDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindMany provides a mock function with given fields: ctx
 func (_m *ArticleRepository) FindMany(ctx context.Context) ([]article.Article, error) {
 	ret := _m.Called(ctx)
@@ -59,6 +84,29 @@ func (_m *ArticleRepository) FindMany(ctx context.Context) ([]article.Article, e
 	return r0, r1
 }
 
+// FindManyByStatus provides a mock function with given fields: ctx, status
+func (_m *ArticleRepository) FindManyByStatus(ctx context.Context, status article.ArticleStatus) ([]article.Article, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []article.Article
+	if rf, ok := ret.Get(0).(func(context.Context, article.ArticleStatus) []article.Article); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]article.Article)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, article.ArticleStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindManySpecificProfile provides a mock function with given fields: ctx, authorId
 func (_m *ArticleRepository) FindManySpecificProfile(ctx context.Context, authorId int64) ([]article.Article, error) {
 	ret := _m.Called(ctx, authorId)
@@ -103,6 +151,27 @@ func (_m *ArticleRepository) Save(ctx context.Context, _a1 article.Article) (int
 	return r0, r1
 }
 
+// SummarizeByAuthor provides a mock function with given fields: ctx, authorId, publishedSince, mostEditedLimit
+func (_m *ArticleRepository) SummarizeByAuthor(ctx context.Context, authorId int64, publishedSince time.Time, mostEditedLimit int) (article.AuthorSummary, error) {
+	ret := _m.Called(ctx, authorId, publishedSince, mostEditedLimit)
+
+	var r0 article.AuthorSummary
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, int) article.AuthorSummary); ok {
+		r0 = rf(ctx, authorId, publishedSince, mostEditedLimit)
+	} else {
+		r0 = ret.Get(0).(article.AuthorSummary)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, int) error); ok {
+		r1 = rf(ctx, authorId, publishedSince, mostEditedLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, ID, authorId, updatedArticle
 func (_m *ArticleRepository) Update(ctx context.Context, ID int64, authorId int64, updatedArticle article.Article) error {
 	ret := _m.Called(ctx, ID, authorId, updatedArticle)
@@ -117,13 +186,13 @@ func (_m *ArticleRepository) Update(ctx context.Context, ID int64, authorId int6
 	return r0
 }
 
-// UpdateStatus provides a mock function with given fields: ctx, ID, authorId, updatedArticle
-func (_m *ArticleRepository) UpdateStatus(ctx context.Context, ID int64, authorId int64, updatedArticle article.Article) error {
-	ret := _m.Called(ctx, ID, authorId, updatedArticle)
+// UpdateStatus provides a mock function with given fields: ctx, ID, authorId, currentStatus, updatedArticle
+func (_m *ArticleRepository) UpdateStatus(ctx context.Context, ID int64, authorId int64, currentStatus article.ArticleStatus, updatedArticle article.Article) error {
+	ret := _m.Called(ctx, ID, authorId, currentStatus, updatedArticle)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, article.Article) error); ok {
-		r0 = rf(ctx, ID, authorId, updatedArticle)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, article.ArticleStatus, article.Article) error); ok {
+		r0 = rf(ctx, ID, authorId, currentStatus, updatedArticle)
 	} else {
 		r0 = ret.Error(0)
 	}
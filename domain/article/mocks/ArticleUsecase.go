@@ -65,13 +65,13 @@ func (_m *ArticleUsecase) EditStatus(ctx context.Context, params article.EditSta
 	return r0
 }
 
-// GetAllPrivate provides a mock function with given fields: ctx
-func (_m *ArticleUsecase) GetAllPrivate(ctx context.Context) response.Response {
-	ret := _m.Called(ctx)
+// GetAllPrivate provides a mock function with given fields: ctx, params
+func (_m *ArticleUsecase) GetAllPrivate(ctx context.Context, params article.ListArticleRequest) response.Response {
+	ret := _m.Called(ctx, params)
 
 	var r0 response.Response
-	if rf, ok := ret.Get(0).(func(context.Context) response.Response); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, article.ListArticleRequest) response.Response); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(response.Response)
@@ -81,13 +81,13 @@ func (_m *ArticleUsecase) GetAllPrivate(ctx context.Context) response.Response {
 	return r0
 }
 
-// GetAllPublic provides a mock function with given fields: ctx
-func (_m *ArticleUsecase) GetAllPublic(ctx context.Context) response.Response {
-	ret := _m.Called(ctx)
+// GetAllPublic provides a mock function with given fields: ctx, params
+func (_m *ArticleUsecase) GetAllPublic(ctx context.Context, params article.ListArticleRequest) response.Response {
+	ret := _m.Called(ctx, params)
 
 	var r0 response.Response
-	if rf, ok := ret.Get(0).(func(context.Context) response.Response); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, article.ListArticleRequest) response.Response); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(response.Response)
@@ -112,3 +112,19 @@ func (_m *ArticleUsecase) GetOne(ctx context.Context, params article.GetOneArtic
 
 	return r0
 }
+
+// GetSummary provides a mock function with given fields: ctx, params
+func (_m *ArticleUsecase) GetSummary(ctx context.Context, params article.ArticleSummaryRequest) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, article.ArticleSummaryRequest) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,103 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/sangianpatrick/devoria-article-service/moderation"
+)
+
+// ModerationContentKind identifies articles to the moderation checks.
+const ModerationContentKind = "article"
+
+// ModerationWorkflow runs the content checks before an article goes live.
+type ModerationWorkflow struct {
+	moderator moderation.ContentModerator
+}
+
+// NewModerationWorkflow is a constructor.
+func NewModerationWorkflow(moderator moderation.ContentModerator) *ModerationWorkflow {
+	return &ModerationWorkflow{
+		moderator: moderator,
+	}
+}
+
+// Register checks the content whenever an article is published or scheduled to be.
+func (w *ModerationWorkflow) Register(m *ArticleStateMachine) {
+	m.Guard(ArticleStatusPublished, w.moderate)
+	m.Guard(ArticleStatusScheduled, w.moderate)
+}
+
+// ContentRejectedError refuses a change of the text of a live article, or of a translation, that moderation blocked.
+type ContentRejectedError struct {
+	Reason  string      `json:"reason"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+func (e *ContentRejectedError) Error() string {
+	return fmt.Sprintf("content was refused: %s", e.Reason)
+}
+
+// Check moderates text written outside of a transition, like an edit of a published article or a translation.
+// Flagged text is accepted with warnings, blocked text returns *ContentRejectedError.
+func (w *ModerationWorkflow) Check(ctx context.Context, ID int64, text ...string) (warnings []string, err error) {
+	result, warnings, err := w.run(ctx, ID, text)
+	if err != nil {
+		return
+	}
+
+	if result.Verdict == moderation.VerdictBlock {
+		return nil, &ContentRejectedError{
+			Reason:  "content was blocked by content moderation",
+			Details: result,
+		}
+	}
+
+	return
+}
+
+func (w *ModerationWorkflow) moderate(ctx context.Context, transition *StatusTransition) (err error) {
+	result, warnings, err := w.run(ctx, transition.Article.ID, []string{
+		transition.Article.Title,
+		transition.Article.Subtitle,
+		transition.Article.Content,
+	})
+	if err != nil {
+		return
+	}
+
+	if result.Verdict == moderation.VerdictBlock {
+		return &TransitionRejectedError{
+			From:    transition.From,
+			To:      transition.To,
+			Reason:  "article was blocked by content moderation",
+			Details: result,
+		}
+	}
+
+	transition.Warnings = append(transition.Warnings, warnings...)
+
+	return
+}
+
+func (w *ModerationWorkflow) run(ctx context.Context, ID int64, text []string) (result moderation.Result, warnings []string, err error) {
+	content := moderation.Content{
+		Kind: ModerationContentKind,
+		Text: text,
+	}
+
+	result, err = w.moderator.Moderate(ctx, content)
+	if err != nil {
+		return
+	}
+
+	if result.Verdict == moderation.VerdictFlag {
+		log.Printf("article %d flagged by content moderation: %+v\n", ID, result.Reasons)
+		for _, reason := range result.Reasons {
+			warnings = append(warnings, fmt.Sprintf("flagged by %s: %s", reason.Check, reason.Rule))
+		}
+	}
+
+	return
+}
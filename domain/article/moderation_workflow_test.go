@@ -0,0 +1,69 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/moderation"
+	moderationMocks "github.com/sangianpatrick/devoria-article-service/moderation/mocks"
+)
+
+func TestModerationWorkflow_BlockedPublish(t *testing.T) {
+	blocked := moderation.Result{
+		Verdict: moderation.VerdictBlock,
+		Reasons: []moderation.Reason{{Check: "spam_link", Verdict: moderation.VerdictBlock, Rule: "more than 20 links"}},
+	}
+
+	moderator := new(moderationMocks.ContentModerator)
+	moderator.On("Moderate", mock.Anything, mock.AnythingOfType("moderation.Content")).Return(blocked, nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewModerationWorkflow(moderator).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusUnlisted,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 1, Status: article.ArticleStatusUnlisted},
+		At:      time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	rejection, ok := err.(*article.TransitionRejectedError)
+	assert.True(t, ok, "should be rejected")
+	assert.Equal(t, blocked, rejection.Details)
+
+	moderator.AssertExpectations(t)
+}
+
+func TestModerationWorkflow_FlaggedPublish(t *testing.T) {
+	flagged := moderation.Result{
+		Verdict: moderation.VerdictFlag,
+		Reasons: []moderation.Reason{{Check: "wordlist", Verdict: moderation.VerdictFlag, Rule: "cheap"}},
+	}
+
+	moderator := new(moderationMocks.ContentModerator)
+	moderator.On("Moderate", mock.Anything, mock.AnythingOfType("moderation.Content")).Return(flagged, nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewModerationWorkflow(moderator).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusUnlisted,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 1, Status: article.ArticleStatusUnlisted},
+		At:      time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	assert.NoError(t, err)
+	assert.Len(t, transition.Warnings, 1)
+
+	moderator.AssertExpectations(t)
+}
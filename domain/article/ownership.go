@@ -0,0 +1,36 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// findOwnedArticle returns the article only when it belongs to the account in the context.
+func findOwnedArticle(ctx context.Context, accountRepo account.AccountRepository, articleRepo ArticleRepository, articleID int64) (article Article, resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return article, response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return article, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	article, err = articleRepo.FindByID(ctx, articleID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return article, response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return article, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if article.Author.ID != account.ID {
+		return article, response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+	}
+
+	return article, nil
+}
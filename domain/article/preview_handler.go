@@ -0,0 +1,150 @@
+package article
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type PreviewLinkHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  PreviewLinkUsecase
+}
+
+func NewPreviewLinkHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase PreviewLinkUsecase,
+) {
+	handler := &PreviewLinkHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/article/{id:[0-9]+}/preview-link/{linkId}/views", bearerAuthMiddleware.VerifyBearer(handler.GetViews)).Methods(http.MethodGet)
+	// The token itself is the credential, reviewers don't need an account.
+	router.HandleFunc("/v1/preview/{token}", handler.View).Methods(http.MethodGet)
+	//Post
+	router.HandleFunc("/v1/article/{id:[0-9]+}/preview-link", bearerAuthMiddleware.VerifyBearer(handler.Create)).Methods(http.MethodPost)
+	//Delete
+	router.HandleFunc("/v1/article/{id:[0-9]+}/preview-link/{linkId}", bearerAuthMiddleware.VerifyBearer(handler.Revoke)).Methods(http.MethodDelete)
+}
+
+func (handler *PreviewLinkHTTPHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params CreatePreviewLinkRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	// The body is optional, an empty one issues a link with the default lifetime.
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil && err != io.EOF {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Create(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *PreviewLinkHTTPHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params RevokePreviewLinkRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	articleID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID = articleID
+	params.LinkID = path["linkId"]
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Revoke(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *PreviewLinkHTTPHandler) GetViews(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetPreviewLinkViewsRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	articleID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID = articleID
+	params.LinkID = path["linkId"]
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetViews(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *PreviewLinkHTTPHandler) View(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params ViewPreviewRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+
+	params.Token = path["token"]
+	params.Viewer = r.URL.Query().Get("viewer")
+	params.IPAddress = middleware.RequestInfoFromContext(ctx).IP
+	params.UserAgent = r.UserAgent()
+
+	err := handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.View(ctx, params)
+	resp.JSON(w)
+}
@@ -0,0 +1,203 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type PreviewLinkRepository interface {
+	Save(ctx context.Context, link PreviewLink) (err error)
+	FindByID(ctx context.Context, ID string) (link PreviewLink, err error)
+	Revoke(ctx context.Context, ID string, articleID int64, revokedAt time.Time) (err error)
+	SaveView(ctx context.Context, view PreviewView) (ID int64, err error)
+	FindViews(ctx context.Context, linkID string) (views []PreviewView, err error)
+}
+
+type previewLinkRepositoryImpl struct {
+	db            *sql.DB
+	tableName     string
+	viewTableName string
+}
+
+func NewPreviewLinkRepository(db *sql.DB, tableName string, viewTableName string) PreviewLinkRepository {
+	return &previewLinkRepositoryImpl{
+		db:            db,
+		tableName:     tableName,
+		viewTableName: viewTableName,
+	}
+}
+
+func (r *previewLinkRepositoryImpl) Save(ctx context.Context, link PreviewLink) (err error) {
+	command := fmt.Sprintf("INSERT INTO %s (id, articleId, createdBy, createdAt, expiresAt) VALUES (?, ?, ?, ?, ?)", r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		link.ID,
+		link.ArticleID,
+		link.CreatedBy,
+		link.CreatedAt,
+		link.ExpiresAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (r *previewLinkRepositoryImpl) FindByID(ctx context.Context, ID string) (link PreviewLink, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, createdBy, createdAt, expiresAt, revokedAt FROM %s WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, ID)
+
+	var revokedAt sql.NullTime
+
+	err = row.Scan(
+		&link.ID,
+		&link.ArticleID,
+		&link.CreatedBy,
+		&link.CreatedAt,
+		&link.ExpiresAt,
+		&revokedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrNotFound
+		return
+	}
+
+	if revokedAt.Valid {
+		link.RevokedAt = &revokedAt.Time
+	}
+
+	return
+}
+
+func (r *previewLinkRepositoryImpl) Revoke(ctx context.Context, ID string, articleID int64, revokedAt time.Time) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET revokedAt = ? WHERE id = ? AND articleId = ? AND revokedAt IS NULL`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		revokedAt,
+		ID,
+		articleID,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *previewLinkRepositoryImpl) SaveView(ctx context.Context, view PreviewView) (ID int64, err error) {
+	command := fmt.Sprintf("INSERT INTO %s (linkId, viewer, ipAddress, userAgent, viewedAt) VALUES (?, ?, ?, ?, ?)", r.viewTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		view.LinkID,
+		view.Viewer,
+		view.IPAddress,
+		view.UserAgent,
+		view.ViewedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	ID, _ = result.LastInsertId()
+
+	return
+}
+
+func (r *previewLinkRepositoryImpl) FindViews(ctx context.Context, linkID string) (views []PreviewView, err error) {
+	query := fmt.Sprintf(`SELECT id, linkId, viewer, ipAddress, userAgent, viewedAt FROM %s WHERE linkId = ? ORDER BY viewedAt DESC`, r.viewTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, linkID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		view := PreviewView{}
+
+		err = rows.Scan(
+			&view.ID,
+			&view.LinkID,
+			&view.Viewer,
+			&view.IPAddress,
+			&view.UserAgent,
+			&view.ViewedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		views = append(views, view)
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package article_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	previewLinkTableName string = "article_preview_link"
+	previewViewTableName string = "article_preview_view"
+)
+
+func TestPreviewRepositoryRevoke_Success(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	ctx := context.TODO()
+	revokedAt := time.Now().In(location)
+
+	expectedCommand := fmt.Sprintf("UPDATE %s SET revokedAt", previewLinkTableName)
+
+	mock.ExpectPrepare(expectedCommand).
+		ExpectExec().
+		WithArgs(revokedAt, "link", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	previewLinkRepository := article.NewPreviewLinkRepository(db, previewLinkTableName, previewViewTableName)
+	err := previewLinkRepository.Revoke(ctx, "link", 1, revokedAt)
+
+	assert.NoError(t, err, "should not be error")
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPreviewRepositoryRevoke_AlreadyRevoked(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	ctx := context.TODO()
+	revokedAt := time.Now().In(location)
+
+	expectedCommand := fmt.Sprintf("UPDATE %s SET revokedAt", previewLinkTableName)
+
+	mock.ExpectPrepare(expectedCommand).
+		ExpectExec().
+		WithArgs(revokedAt, "link", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	previewLinkRepository := article.NewPreviewLinkRepository(db, previewLinkTableName, previewViewTableName)
+	err := previewLinkRepository.Revoke(ctx, "link", 1, revokedAt)
+
+	assert.Equal(t, exception.ErrNotFound, err, "should be not found")
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
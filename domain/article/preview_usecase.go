@@ -0,0 +1,222 @@
+package article
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/jwt"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// PreviewLinkDefaultTTL is the lifetime of a preview link when none is requested.
+const PreviewLinkDefaultTTL = time.Hour * 72
+
+const previewURLFormat = "/v1/preview/%s"
+
+type PreviewLinkUsecase interface {
+	Create(ctx context.Context, params CreatePreviewLinkRequest) (resp response.Response)
+	Revoke(ctx context.Context, params RevokePreviewLinkRequest) (resp response.Response)
+	GetViews(ctx context.Context, params GetPreviewLinkViewsRequest) (resp response.Response)
+	View(ctx context.Context, params ViewPreviewRequest) (resp response.Response)
+}
+
+type previewLinkUsecaseImpl struct {
+	jsonWebToken jwt.JSONWebToken
+	location     *time.Location
+	repository   PreviewLinkRepository
+	articleRepo  ArticleRepository
+	accountRepo  account.AccountRepository
+}
+
+func NewPreviewLinkUsecase(
+	jsonWebToken jwt.JSONWebToken,
+	location *time.Location,
+	repository PreviewLinkRepository,
+	articleRepo ArticleRepository,
+	accountRepo account.AccountRepository,
+) PreviewLinkUsecase {
+	return &previewLinkUsecaseImpl{
+		jsonWebToken: jsonWebToken,
+		location:     location,
+		repository:   repository,
+		articleRepo:  articleRepo,
+		accountRepo:  accountRepo,
+	}
+}
+
+func (u *previewLinkUsecaseImpl) generateLinkID() (ID string, err error) {
+	b := make([]byte, 16)
+
+	_, err = rand.Read(b)
+	if err != nil {
+		return
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (u *previewLinkUsecaseImpl) Create(ctx context.Context, params CreatePreviewLinkRequest) (resp response.Response) {
+	article, resp := findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ArticleID)
+	if resp != nil {
+		return resp
+	}
+
+	//Only unreleased articles can be previewed, everything else is either public or retired
+	if !article.Status.IsUnreleased() {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+	}
+
+	ttl := PreviewLinkDefaultTTL
+	if params.ExpiresInHours > 0 {
+		ttl = time.Hour * time.Duration(params.ExpiresInHours)
+	}
+
+	linkID, err := u.generateLinkID()
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	now := time.Now().In(u.location)
+
+	link := PreviewLink{}
+	link.ID = linkID
+	link.ArticleID = article.ID
+	link.CreatedBy = article.Author.ID
+	link.CreatedAt = now
+	link.ExpiresAt = now.Add(ttl)
+
+	claims := PreviewLinkClaims{}
+	claims.Id = link.ID
+	claims.Subject = fmt.Sprintf("%d", article.ID)
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = link.ExpiresAt.Unix()
+	claims.ArticleID = article.ID
+
+	token, err := u.jsonWebToken.Sign(ctx, claims)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	err = u.repository.Save(ctx, link)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	createPreviewLinkResponse := CreatePreviewLinkResponse{}
+	createPreviewLinkResponse.ID = link.ID
+	createPreviewLinkResponse.Token = token
+	createPreviewLinkResponse.URL = fmt.Sprintf(previewURLFormat, token)
+	createPreviewLinkResponse.ExpiresAt = link.ExpiresAt
+
+	return response.Success(response.StatusCreated, createPreviewLinkResponse)
+}
+
+func (u *previewLinkUsecaseImpl) Revoke(ctx context.Context, params RevokePreviewLinkRequest) (resp response.Response) {
+	_, resp = findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ArticleID)
+	if resp != nil {
+		return resp
+	}
+
+	err := u.repository.Revoke(ctx, params.LinkID, params.ArticleID, time.Now().In(u.location))
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, params)
+}
+
+func (u *previewLinkUsecaseImpl) GetViews(ctx context.Context, params GetPreviewLinkViewsRequest) (resp response.Response) {
+	_, resp = findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ArticleID)
+	if resp != nil {
+		return resp
+	}
+
+	link, err := u.repository.FindByID(ctx, params.LinkID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if link.ArticleID != params.ArticleID {
+		return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	views, err := u.repository.FindViews(ctx, link.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, views)
+}
+
+func (u *previewLinkUsecaseImpl) View(ctx context.Context, params ViewPreviewRequest) (resp response.Response) {
+	token, err := u.jsonWebToken.Parse(ctx, params.Token, &PreviewLinkClaims{})
+	if err != nil {
+		return response.Error(response.StatusUnauthorized, nil, exception.ErrUnauthorized)
+	}
+	claims := (token.Claims).(*PreviewLinkClaims)
+
+	link, err := u.repository.FindByID(ctx, claims.Id)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusUnauthorized, nil, exception.ErrUnauthorized)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	now := time.Now().In(u.location)
+
+	//The token signature alone is not enough, the link may have been revoked since
+	if link.RevokedAt != nil || link.ArticleID != claims.ArticleID || !now.Before(link.ExpiresAt) {
+		return response.Error(response.StatusUnauthorized, nil, exception.ErrUnauthorized)
+	}
+
+	article, err := u.articleRepo.FindByID(ctx, link.ArticleID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if !article.Status.IsUnreleased() {
+		return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	view := PreviewView{}
+	view.LinkID = link.ID
+	view.Viewer = params.Viewer
+	view.IPAddress = params.IPAddress
+	view.UserAgent = params.UserAgent
+	view.ViewedAt = now
+
+	//A failure to record the view should not hide the draft from the reviewer
+	if _, err = u.repository.SaveView(ctx, view); err != nil {
+		log.Println(err)
+	}
+
+	previewArticleResponse := PreviewArticleResponse{}
+	previewArticleResponse.ID = article.ID
+	previewArticleResponse.Title = article.Title
+	previewArticleResponse.Subtitle = article.Subtitle
+	previewArticleResponse.Content = article.Content
+	previewArticleResponse.Status = article.Status
+	previewArticleResponse.CreatedAt = article.CreatedAt
+	previewArticleResponse.LastModifiedAt = article.LastModifiedAt
+	previewArticleResponse.AuthorID = article.Author.ID
+	previewArticleResponse.ReadOnly = true
+	previewArticleResponse.ExpiresAt = link.ExpiresAt
+
+	return response.Success(response.StatusOK, previewArticleResponse)
+}
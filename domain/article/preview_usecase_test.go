@@ -0,0 +1,175 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	jsonWebTokenMocks "github.com/sangianpatrick/devoria-article-service/jwt/mocks"
+)
+
+func TestPreviewUsecaseCreate_Success(t *testing.T) {
+	var dataArticle = article.Article{
+		ID:        1,
+		Title:     "test",
+		Subtitle:  "test",
+		Content:   "test",
+		Status:    article.ArticleStatusDraft,
+		CreatedAt: time.Now().In(location),
+		Author: entity.Account{
+			ID: 1,
+		},
+	}
+
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	jsonWebToken.On("Sign", mock.Anything, mock.AnythingOfType("article.PreviewLinkClaims")).Return("mock token", nil)
+
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 1}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(dataArticle, nil)
+
+	previewRepo := new(articleMocks.PreviewLinkRepository)
+	previewRepo.On("Save", mock.Anything, mock.AnythingOfType("article.PreviewLink")).Return(nil)
+
+	u := article.NewPreviewLinkUsecase(jsonWebToken, location, previewRepo, articleRepo, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	params := article.CreatePreviewLinkRequest{
+		ArticleID:      1,
+		ExpiresInHours: 2,
+	}
+
+	resp := u.Create(ctx, params)
+	assert.NoError(t, resp.Err())
+
+	jsonWebToken.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	previewRepo.AssertExpectations(t)
+}
+
+func TestPreviewUsecaseCreate_NotOwner(t *testing.T) {
+	var dataArticle = article.Article{
+		ID:     1,
+		Status: article.ArticleStatusDraft,
+		Author: entity.Account{
+			ID: 2,
+		},
+	}
+
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 1}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(dataArticle, nil)
+
+	previewRepo := new(articleMocks.PreviewLinkRepository)
+
+	u := article.NewPreviewLinkUsecase(jsonWebToken, location, previewRepo, articleRepo, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Create(ctx, article.CreatePreviewLinkRequest{ArticleID: 1})
+	assert.Error(t, resp.Err())
+
+	jsonWebToken.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	previewRepo.AssertExpectations(t)
+}
+
+func TestPreviewUsecaseView_Success(t *testing.T) {
+	var dataArticle = article.Article{
+		ID:        1,
+		Title:     "test",
+		Subtitle:  "test",
+		Content:   "test",
+		Status:    article.ArticleStatusDraft,
+		CreatedAt: time.Now().In(location),
+		Author: entity.Account{
+			ID: 1,
+		},
+	}
+
+	var dataLink = article.PreviewLink{
+		ID:        "link",
+		ArticleID: 1,
+		CreatedBy: 1,
+		CreatedAt: time.Now().In(location),
+		ExpiresAt: time.Now().In(location).Add(time.Hour),
+	}
+
+	claims := &article.PreviewLinkClaims{ArticleID: 1}
+	claims.Id = "link"
+
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	jsonWebToken.On("Parse", mock.Anything, "mock token", mock.Anything).Return(&jwtgo.Token{Claims: claims, Valid: true}, nil)
+
+	accountRepo := new(accountMocks.AccountRepository)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(dataArticle, nil)
+
+	previewRepo := new(articleMocks.PreviewLinkRepository)
+	previewRepo.On("FindByID", mock.Anything, "link").Return(dataLink, nil)
+	previewRepo.On("SaveView", mock.Anything, mock.AnythingOfType("article.PreviewView")).Return(int64(1), nil)
+
+	u := article.NewPreviewLinkUsecase(jsonWebToken, location, previewRepo, articleRepo, accountRepo)
+
+	params := article.ViewPreviewRequest{
+		Token:     "mock token",
+		Viewer:    "reviewer",
+		IPAddress: "127.0.0.1",
+	}
+
+	resp := u.View(context.TODO(), params)
+	assert.NoError(t, resp.Err())
+
+	jsonWebToken.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	previewRepo.AssertExpectations(t)
+}
+
+func TestPreviewUsecaseView_Revoked(t *testing.T) {
+	revokedAt := time.Now().In(location)
+	var dataLink = article.PreviewLink{
+		ID:        "link",
+		ArticleID: 1,
+		ExpiresAt: time.Now().In(location).Add(time.Hour),
+		RevokedAt: &revokedAt,
+	}
+
+	claims := &article.PreviewLinkClaims{ArticleID: 1}
+	claims.Id = "link"
+
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	jsonWebToken.On("Parse", mock.Anything, "mock token", mock.Anything).Return(&jwtgo.Token{Claims: claims, Valid: true}, nil)
+
+	accountRepo := new(accountMocks.AccountRepository)
+	articleRepo := new(articleMocks.ArticleRepository)
+
+	previewRepo := new(articleMocks.PreviewLinkRepository)
+	previewRepo.On("FindByID", mock.Anything, "link").Return(dataLink, nil)
+
+	u := article.NewPreviewLinkUsecase(jsonWebToken, location, previewRepo, articleRepo, accountRepo)
+
+	resp := u.View(context.TODO(), article.ViewPreviewRequest{Token: "mock token"})
+	assert.Error(t, resp.Err())
+
+	jsonWebToken.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	previewRepo.AssertExpectations(t)
+}
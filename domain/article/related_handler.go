@@ -0,0 +1,68 @@
+package article
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type RelatedArticleHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  RelatedArticleUsecase
+}
+
+func NewRelatedArticleHTTPHandler(
+	router *mux.Router,
+	basicAuthMiddleware middleware.RouteMiddleware,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase RelatedArticleUsecase,
+) {
+	handler := &RelatedArticleHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/article/{id:[0-9]+}/related", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, handler.GetRelated)).Methods(http.MethodGet)
+}
+
+func (handler *RelatedArticleHTTPHandler) GetRelated(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetRelatedArticlesRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		params.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+			resp.JSON(w)
+			return
+		}
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetRelated(ctx, params)
+	resp.JSON(w)
+}
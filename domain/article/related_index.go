@@ -0,0 +1,287 @@
+package article
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Terms found in the title and subtitle describe the article better than the body does.
+const (
+	relatedTitleWeight    = 3
+	relatedSubtitleWeight = 2
+	relatedContentWeight  = 1
+)
+
+// relatedStopWords are skipped while indexing, the service serves both Indonesian and English.
+var relatedStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "are": true, "was": true, "with": true, "this": true, "that": true,
+	"from": true, "have": true, "has": true, "not": true, "but": true, "you": true, "your": true, "can": true,
+	"yang": true, "dan": true, "di": true, "ke": true, "dari": true, "ini": true, "itu": true, "untuk": true,
+	"dengan": true, "pada": true, "adalah": true, "dalam": true, "tidak": true, "akan": true, "atau": true, "juga": true,
+}
+
+// RelatedArticle is a similar article found by the index.
+type RelatedArticle struct {
+	ArticleID int64
+	Score     float64
+}
+
+// RelatedArticleIndex is an in-process TF-IDF index over the published articles.
+// The weights are kept up to date on each write, since every write moves the inverse document frequencies,
+// so a query only reads the articles that share a term with the given one.
+type RelatedArticleIndex struct {
+	mu        sync.RWMutex
+	documents map[int64]map[string]float64
+	postings  map[string]map[int64]bool
+	weights   map[int64]map[string]float64
+	norms     map[int64]float64
+}
+
+func NewRelatedArticleIndex() *RelatedArticleIndex {
+	return &RelatedArticleIndex{
+		documents: make(map[int64]map[string]float64),
+		postings:  make(map[string]map[int64]bool),
+		weights:   make(map[int64]map[string]float64),
+		norms:     make(map[int64]float64),
+	}
+}
+
+// Build indexes every published article, it is meant to run once at startup.
+func (idx *RelatedArticleIndex) Build(ctx context.Context, repository ArticleRepository) (err error) {
+	articles, err := repository.FindManyByStatus(ctx, ArticleStatusPublished)
+	if err != nil {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, article := range articles {
+		idx.remove(article.ID)
+		if article.Status == ArticleStatusPublished {
+			idx.add(article.ID, termFrequencies(article))
+		}
+	}
+	idx.reweigh()
+
+	return
+}
+
+// Refresh indexes a published article again and drops any other one from the index.
+func (idx *RelatedArticleIndex) Refresh(article Article) {
+	if article.Status != ArticleStatusPublished {
+		idx.Remove(article.ID)
+		return
+	}
+
+	terms := termFrequencies(article)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(article.ID)
+	idx.add(article.ID, terms)
+	idx.reweigh()
+}
+
+func (idx *RelatedArticleIndex) Remove(ID int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.remove(ID) {
+		idx.reweigh()
+	}
+}
+
+func (idx *RelatedArticleIndex) add(ID int64, terms map[string]float64) {
+	if len(terms) == 0 {
+		return
+	}
+
+	idx.documents[ID] = terms
+	for term := range terms {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[int64]bool)
+		}
+		idx.postings[term][ID] = true
+	}
+}
+
+func (idx *RelatedArticleIndex) remove(ID int64) (removed bool) {
+	terms, ok := idx.documents[ID]
+	if !ok {
+		return
+	}
+
+	for term := range terms {
+		delete(idx.postings[term], ID)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+
+	delete(idx.documents, ID)
+	delete(idx.weights, ID)
+	delete(idx.norms, ID)
+
+	return true
+}
+
+// reweigh computes the weights and norms of every article against the current document frequencies.
+func (idx *RelatedArticleIndex) reweigh() {
+	total := float64(len(idx.documents))
+
+	for ID, terms := range idx.documents {
+		weights := make(map[string]float64, len(terms))
+
+		var norm float64
+		for term, frequency := range terms {
+			inverse := math.Log((1+total)/(1+float64(len(idx.postings[term])))) + 1
+			weights[term] = frequency * inverse
+			norm += weights[term] * weights[term]
+		}
+
+		idx.weights[ID] = weights
+		idx.norms[ID] = math.Sqrt(norm)
+	}
+}
+
+// Similar returns up to limit articles ordered by their cosine similarity to the given one.
+func (idx *RelatedArticleIndex) Similar(ID int64, limit int) (related []RelatedArticle) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	query, ok := idx.weights[ID]
+	if !ok {
+		return
+	}
+
+	dots := make(map[int64]float64)
+	for term, weight := range query {
+		for candidateID := range idx.postings[term] {
+			if candidateID == ID {
+				continue
+			}
+			dots[candidateID] += weight * idx.weights[candidateID][term]
+		}
+	}
+
+	for candidateID, dot := range dots {
+		if dot <= 0 {
+			continue
+		}
+
+		related = append(related, RelatedArticle{
+			ArticleID: candidateID,
+			Score:     dot / (idx.norms[ID] * idx.norms[candidateID]),
+		})
+	}
+
+	sort.Slice(related, func(i, j int) bool {
+		if related[i].Score == related[j].Score {
+			return related[i].ArticleID > related[j].ArticleID
+		}
+		return related[i].Score > related[j].Score
+	})
+
+	if len(related) > limit {
+		related = related[:limit]
+	}
+
+	return
+}
+
+// termFrequencies counts the weighted terms of an article relative to its length.
+func termFrequencies(article Article) (frequencies map[string]float64) {
+	frequencies = make(map[string]float64)
+
+	var total float64
+	add := func(text string, weight float64) {
+		for _, term := range tokenize(text) {
+			frequencies[term] += weight
+			total += weight
+		}
+	}
+
+	add(article.Title, relatedTitleWeight)
+	add(article.Subtitle, relatedSubtitleWeight)
+	add(article.Content, relatedContentWeight)
+
+	for term := range frequencies {
+		frequencies[term] /= total
+	}
+
+	return
+}
+
+func tokenize(text string) (terms []string) {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	for _, field := range fields {
+		if len([]rune(field)) < 2 || relatedStopWords[field] {
+			continue
+		}
+		terms = append(terms, field)
+	}
+
+	return
+}
+
+// indexedArticleRepository keeps the related index in step with every write to the articles.
+type indexedArticleRepository struct {
+	ArticleRepository
+	index *RelatedArticleIndex
+}
+
+// NewIndexedArticleRepository wraps the repository so edits and status changes refresh the index.
+func NewIndexedArticleRepository(repository ArticleRepository, index *RelatedArticleIndex) ArticleRepository {
+	return &indexedArticleRepository{
+		ArticleRepository: repository,
+		index:             index,
+	}
+}
+
+func (r *indexedArticleRepository) Update(ctx context.Context, ID int64, authorId int64, updatedArticle Article) (err error) {
+	err = r.ArticleRepository.Update(ctx, ID, authorId, updatedArticle)
+	if err != nil {
+		return
+	}
+
+	r.refresh(ctx, ID)
+
+	return
+}
+
+func (r *indexedArticleRepository) UpdateStatus(ctx context.Context, ID int64, authorId int64, currentStatus ArticleStatus, updatedArticle Article) (err error) {
+	err = r.ArticleRepository.UpdateStatus(ctx, ID, authorId, currentStatus, updatedArticle)
+	if err != nil {
+		return
+	}
+
+	if updatedArticle.Status != ArticleStatusPublished {
+		r.index.Remove(ID)
+		return
+	}
+
+	r.refresh(ctx, ID)
+
+	return
+}
+
+// refresh reads the stored row back since the updates only carry the changed columns.
+func (r *indexedArticleRepository) refresh(ctx context.Context, ID int64) {
+	article, err := r.ArticleRepository.FindByID(ctx, ID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	r.index.Refresh(article)
+}
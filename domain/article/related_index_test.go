@@ -0,0 +1,83 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+)
+
+var dataIndexedArticles = []article.Article{
+	{ID: 1, Title: "Golang concurrency patterns", Subtitle: "Goroutines and channels", Content: "Channels let goroutines communicate safely.", Status: article.ArticleStatusPublished},
+	{ID: 2, Title: "Channels in golang", Subtitle: "Buffered and unbuffered", Content: "A buffered channel blocks only when full, goroutines wait otherwise.", Status: article.ArticleStatusPublished},
+	{ID: 3, Title: "Resep nasi goreng", Subtitle: "Masakan rumahan", Content: "Nasi goreng dengan kecap dan telur.", Status: article.ArticleStatusPublished},
+}
+
+func TestRelatedArticleIndexSimilar(t *testing.T) {
+	index := article.NewRelatedArticleIndex()
+	for _, a := range dataIndexedArticles {
+		index.Refresh(a)
+	}
+
+	related := index.Similar(1, 5)
+
+	assert.Len(t, related, 1)
+	assert.Equal(t, int64(2), related[0].ArticleID)
+	assert.True(t, related[0].Score > 0 && related[0].Score <= 1)
+}
+
+func TestRelatedArticleIndexBuild_ReweighsOnPublish(t *testing.T) {
+	repository := new(articleMocks.ArticleRepository)
+	repository.On("FindManyByStatus", mock.Anything, article.ArticleStatusPublished).Return(dataIndexedArticles, nil)
+
+	index := article.NewRelatedArticleIndex()
+	assert.NoError(t, index.Build(context.TODO(), repository))
+
+	before := index.Similar(1, 5)
+	assert.Len(t, before, 1)
+
+	//Another golang article makes the shared terms less telling
+	index.Refresh(article.Article{ID: 4, Title: "Golang generics", Content: "Type parameters for golang channels.", Status: article.ArticleStatusPublished})
+
+	after := index.Similar(1, 5)
+	assert.Len(t, after, 2)
+	assert.Equal(t, int64(2), after[0].ArticleID)
+	assert.NotEqual(t, before[0].Score, after[0].Score)
+}
+
+func TestRelatedArticleIndexRefresh_Unpublished(t *testing.T) {
+	index := article.NewRelatedArticleIndex()
+	for _, a := range dataIndexedArticles {
+		index.Refresh(a)
+	}
+
+	archived := dataIndexedArticles[1]
+	archived.Status = article.ArticleStatusArchived
+	index.Refresh(archived)
+
+	assert.Empty(t, index.Similar(1, 5))
+	assert.Empty(t, index.Similar(2, 5))
+}
+
+func TestIndexedArticleRepositoryUpdateStatus_Published(t *testing.T) {
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("UpdateStatus", mock.Anything, int64(2), int64(1), article.ArticleStatusUnlisted, mock.AnythingOfType("article.Article")).Return(nil)
+	articleRepo.On("FindByID", mock.Anything, int64(2)).Return(dataIndexedArticles[1], nil)
+
+	index := article.NewRelatedArticleIndex()
+	index.Refresh(dataIndexedArticles[0])
+
+	repository := article.NewIndexedArticleRepository(articleRepo, index)
+	err := repository.UpdateStatus(context.TODO(), 2, 1, article.ArticleStatusUnlisted, article.Article{Status: article.ArticleStatusPublished, Author: entity.Account{ID: 1}})
+	assert.NoError(t, err)
+
+	related := index.Similar(1, 5)
+	assert.Len(t, related, 1)
+
+	articleRepo.AssertExpectations(t)
+}
@@ -0,0 +1,95 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// RelatedArticlesDefaultLimit is the number of related articles returned when none is requested.
+const RelatedArticlesDefaultLimit = 5
+
+type RelatedArticleUsecase interface {
+	GetRelated(ctx context.Context, params GetRelatedArticlesRequest) (resp response.Response)
+}
+
+type relatedArticleUsecaseImpl struct {
+	index      *RelatedArticleIndex
+	repository ArticleRepository
+}
+
+func NewRelatedArticleUsecase(index *RelatedArticleIndex, repository ArticleRepository) RelatedArticleUsecase {
+	return &relatedArticleUsecaseImpl{
+		index:      index,
+		repository: repository,
+	}
+}
+
+func (u *relatedArticleUsecaseImpl) GetRelated(ctx context.Context, params GetRelatedArticlesRequest) (resp response.Response) {
+	article, err := u.repository.FindByID(ctx, params.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	//Unpublished articles are not visible to readers
+	if article.Status != ArticleStatusPublished {
+		return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	limit := params.Limit
+	if limit < 1 {
+		limit = RelatedArticlesDefaultLimit
+	}
+
+	similar := u.index.Similar(article.ID, limit)
+
+	IDs := make([]int64, len(similar))
+	for i, related := range similar {
+		IDs[i] = related.ArticleID
+	}
+
+	elements, err := u.repository.FindByIDs(ctx, IDs)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	byID := make(map[int64]Article, len(elements))
+	for _, element := range elements {
+		byID[element.ID] = element
+	}
+
+	arr := []RelatedArticleResponse{}
+
+	for _, related := range similar {
+		element, ok := byID[related.ArticleID]
+		if !ok || element.Status != ArticleStatusPublished {
+			continue
+		}
+
+		restrict(ctx, &element)
+
+		m := RelatedArticleResponse{}
+		m.ID = element.ID
+		m.Title = element.Title
+		m.Subtitle = element.Subtitle
+		m.Content = element.Content
+		m.Language = element.Language
+		m.AccessLevel = element.AccessLevel
+		m.Locked = element.Locked
+		m.Status = element.Status
+		m.CreatedAt = element.CreatedAt
+		m.PublishedAt = element.PublishedAt
+		m.LastModifiedAt = element.LastModifiedAt
+		m.AuthorID = element.Author.ID
+		m.Slug = element.Slug
+		m.Score = related.Score
+
+		arr = append(arr, m)
+	}
+
+	return response.Success(response.StatusOK, arr)
+}
@@ -0,0 +1,66 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+func TestRelatedArticleUsecaseGetRelated_Success(t *testing.T) {
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(dataIndexedArticles[0], nil)
+	articleRepo.On("FindByIDs", mock.Anything, []int64{2}).Return([]article.Article{dataIndexedArticles[1]}, nil).Once()
+
+	index := article.NewRelatedArticleIndex()
+	for _, a := range dataIndexedArticles {
+		index.Refresh(a)
+	}
+
+	u := article.NewRelatedArticleUsecase(index, articleRepo)
+
+	resp := u.GetRelated(context.TODO(), article.GetRelatedArticlesRequest{ID: 1})
+	assert.NoError(t, resp.Err())
+
+	articleRepo.AssertExpectations(t)
+}
+
+func TestRelatedArticleUsecaseGetRelated_NotPublished(t *testing.T) {
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft}, nil)
+
+	u := article.NewRelatedArticleUsecase(article.NewRelatedArticleIndex(), articleRepo)
+
+	resp := u.GetRelated(context.TODO(), article.GetRelatedArticlesRequest{ID: 1})
+	assert.Equal(t, exception.ErrNotFound, resp.Err())
+
+	articleRepo.AssertExpectations(t)
+}
+
+func TestRelatedArticleUsecaseGetRelated_SkipsUnpublished(t *testing.T) {
+	unlisted := dataIndexedArticles[1]
+	unlisted.Status = article.ArticleStatusUnlisted
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(dataIndexedArticles[0], nil)
+	articleRepo.On("FindByIDs", mock.Anything, []int64{2}).Return([]article.Article{unlisted}, nil)
+
+	index := article.NewRelatedArticleIndex()
+	for _, a := range dataIndexedArticles {
+		index.Refresh(a)
+	}
+
+	u := article.NewRelatedArticleUsecase(index, articleRepo)
+
+	resp := u.GetRelated(context.TODO(), article.GetRelatedArticlesRequest{ID: 1})
+	assert.NoError(t, resp.Err())
+	assert.Empty(t, response.Data(resp))
+
+	articleRepo.AssertExpectations(t)
+}
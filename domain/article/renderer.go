@@ -0,0 +1,169 @@
+package article
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// ArticleRenderers are the formats GetOne speaks besides JSON, add a renderer here to serve a new format.
+func ArticleRenderers() []response.Renderer {
+	return []response.Renderer{
+		markdownRenderer{},
+		htmlRenderer{},
+		plainTextRenderer{},
+	}
+}
+
+// renderable pulls the article and its optional author profile out of the response data.
+func renderable(data interface{}) (article Article, author *AuthorProfile, err error) {
+	switch value := data.(type) {
+	case Article:
+		return value, nil, nil
+	case ExpandedArticleResponse:
+		return value.Article, value.Author, nil
+	default:
+		return article, nil, response.ErrUnrenderable
+	}
+}
+
+func paragraphs(content string) (parts []string) {
+	for _, part := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return
+}
+
+// markdownRenderer writes the article as markdown with YAML front matter.
+type markdownRenderer struct{}
+
+func (markdownRenderer) ContentType() string {
+	return "text/markdown"
+}
+
+func (markdownRenderer) Render(w io.Writer, data interface{}) (err error) {
+	article, author, err := renderable(data)
+	if err != nil {
+		return
+	}
+
+	// JSON strings are valid double quoted YAML scalars, titles never break the front matter.
+	quote := func(s string) string {
+		quoted, _ := json.Marshal(s)
+		return string(quoted)
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "id: %d\n", article.ID)
+	fmt.Fprintf(&b, "title: %s\n", quote(article.Title))
+	fmt.Fprintf(&b, "subtitle: %s\n", quote(article.Subtitle))
+	fmt.Fprintf(&b, "language: %s\n", quote(article.Language))
+	fmt.Fprintf(&b, "status: %s\n", article.Status)
+	fmt.Fprintf(&b, "accessLevel: %s\n", article.AccessLevel)
+	fmt.Fprintf(&b, "authorId: %d\n", article.Author.ID)
+	if author != nil {
+		fmt.Fprintf(&b, "author: %s\n", quote(author.Name))
+	}
+	if article.PublishedAt != nil {
+		fmt.Fprintf(&b, "publishedAt: %s\n", article.PublishedAt.Format(time.RFC3339))
+	}
+	if article.LastModifiedAt != nil {
+		fmt.Fprintf(&b, "lastModifiedAt: %s\n", article.LastModifiedAt.Format(time.RFC3339))
+	}
+	if article.Locked {
+		b.WriteString("locked: true\n")
+	}
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# %s\n\n", article.Title)
+	if article.Subtitle != "" {
+		fmt.Fprintf(&b, "## %s\n\n", article.Subtitle)
+	}
+	b.WriteString(strings.TrimSpace(article.Content))
+	b.WriteString("\n")
+
+	_, err = io.WriteString(w, b.String())
+	return
+}
+
+// htmlRenderer writes the article as a standalone HTML document.
+type htmlRenderer struct{}
+
+func (htmlRenderer) ContentType() string {
+	return "text/html"
+}
+
+func (htmlRenderer) Render(w io.Writer, data interface{}) (err error) {
+	article, author, err := renderable(data)
+	if err != nil {
+		return
+	}
+
+	language := article.Language
+	if language == "" {
+		language = ArticleDefaultLanguage
+	}
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n")
+	fmt.Fprintf(&b, "<html lang=\"%s\">\n<head>\n<meta charset=\"utf-8\">\n", html.EscapeString(language))
+	fmt.Fprintf(&b, "<title>%s</title>\n", html.EscapeString(article.Title))
+	if article.Subtitle != "" {
+		fmt.Fprintf(&b, "<meta name=\"description\" content=\"%s\">\n", html.EscapeString(article.Subtitle))
+	}
+	if author != nil {
+		fmt.Fprintf(&b, "<meta name=\"author\" content=\"%s\">\n", html.EscapeString(author.Name))
+	}
+	b.WriteString("</head>\n<body>\n<article>\n<header>\n")
+	fmt.Fprintf(&b, "<h1>%s</h1>\n", html.EscapeString(article.Title))
+	if article.Subtitle != "" {
+		fmt.Fprintf(&b, "<p>%s</p>\n", html.EscapeString(article.Subtitle))
+	}
+	if article.PublishedAt != nil {
+		fmt.Fprintf(&b, "<time datetime=\"%s\">%s</time>\n", article.PublishedAt.Format(time.RFC3339), article.PublishedAt.Format("2 January 2006"))
+	}
+	b.WriteString("</header>\n")
+	for _, paragraph := range paragraphs(article.Content) {
+		fmt.Fprintf(&b, "<p>%s</p>\n", strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+	}
+	b.WriteString("</article>\n</body>\n</html>\n")
+
+	_, err = io.WriteString(w, b.String())
+	return
+}
+
+// plainTextRenderer writes the article as plain text, handy for terminals and text to speech.
+type plainTextRenderer struct{}
+
+func (plainTextRenderer) ContentType() string {
+	return "text/plain"
+}
+
+func (plainTextRenderer) Render(w io.Writer, data interface{}) (err error) {
+	article, _, err := renderable(data)
+	if err != nil {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString(article.Title)
+	b.WriteString("\n")
+	if article.Subtitle != "" {
+		b.WriteString(article.Subtitle)
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(strings.Join(paragraphs(article.Content), "\n\n"))
+	b.WriteString("\n")
+
+	_, err = io.WriteString(w, b.String())
+	return
+}
@@ -5,7 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/event"
 	"github.com/sangianpatrick/devoria-article-service/exception"
 )
 
@@ -15,24 +19,37 @@ type ArticleRepository interface {
 	FindByID(ctx context.Context, ID int64) (article Article, err error)
 	FindMany(ctx context.Context) (bunchOfArticles []Article, err error)
 	FindManySpecificProfile(ctx context.Context, authorId int64) (bunchOfArticles []Article, err error)
-	UpdateStatus(ctx context.Context, ID int64, authorId int64, updatedArticle Article) (err error)
+	FindManyByStatus(ctx context.Context, status ArticleStatus) (bunchOfArticles []Article, err error)
+	FindByIDs(ctx context.Context, IDs []int64) (bunchOfArticles []Article, err error)
+	UpdateStatus(ctx context.Context, ID int64, authorId int64, currentStatus ArticleStatus, updatedArticle Article) (err error)
+	SummarizeByAuthor(ctx context.Context, authorId int64, publishedSince time.Time, mostEditedLimit int) (summary AuthorSummary, err error)
 }
 
 type articleRepositoryImpl struct {
 	db        *sql.DB
 	tableName string
+	outbox    event.Outbox
 }
 
-func NewArticleRepository(db *sql.DB, tableName string) ArticleRepository {
+// NewArticleRepository writes the article events to the outbox in the same transaction as the change.
+func NewArticleRepository(db *sql.DB, tableName string, outbox event.Outbox) ArticleRepository {
 	return &articleRepositoryImpl{
 		db:        db,
 		tableName: tableName,
+		outbox:    outbox,
 	}
 }
 
 func (r *articleRepositoryImpl) Save(ctx context.Context, article Article) (ID int64, err error) {
-	command := fmt.Sprintf("INSERT INTO %s (title, subtitle, content, status, createdAt, authorId) VALUES (?, ?, ?, ?, ?, ?)", r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, command)
+	tx, err := database.Begin(ctx, r.db)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer tx.Rollback()
+
+	command := fmt.Sprintf("INSERT INTO %s (title, subtitle, content, language, accessLevel, status, createdAt, authorId, slug) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)", r.tableName)
+	stmt, err := tx.PrepareContext(ctx, command)
 	if err != nil {
 		log.Println(err)
 		return
@@ -44,9 +61,12 @@ func (r *articleRepositoryImpl) Save(ctx context.Context, article Article) (ID i
 		article.Title,
 		article.Subtitle,
 		article.Content,
+		article.Language,
+		article.AccessLevel,
 		article.Status,
 		article.CreatedAt,
 		article.Author.ID,
+		article.Slug,
 	)
 
 	if err != nil {
@@ -56,12 +76,37 @@ func (r *articleRepositoryImpl) Save(ctx context.Context, article Article) (ID i
 
 	ID, _ = result.LastInsertId()
 
+	payload := ArticleEvent{
+		ID:       ID,
+		AuthorID: article.Author.ID,
+		Title:    article.Title,
+		Subtitle: article.Subtitle,
+		Status:   article.Status,
+	}
+	err = r.record(ctx, tx.Tx, event.ArticleCreated, ID, payload, article.CreatedAt)
+	if err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+	}
+
 	return
 }
 
 func (r *articleRepositoryImpl) Update(ctx context.Context, ID int64, authorId int64, updatedArticle Article) (err error) {
-	command := fmt.Sprintf(`UPDATE %s SET title = ?, subtitle = ?, content = ?, lastModifiedAt = ? WHERE id = ? AND authorId = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, command)
+	tx, err := database.Begin(ctx, r.db)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer tx.Rollback()
+
+	command := fmt.Sprintf(`UPDATE %s SET title = ?, subtitle = ?, content = ?, accessLevel = COALESCE(NULLIF(?, ''), accessLevel), lastModifiedAt = ?, editCount = editCount + 1 WHERE id = ? AND authorId = ?`, r.tableName)
+	stmt, err := tx.PrepareContext(ctx, command)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -74,6 +119,7 @@ func (r *articleRepositoryImpl) Update(ctx context.Context, ID int64, authorId i
 		updatedArticle.Title,
 		updatedArticle.Subtitle,
 		updatedArticle.Content,
+		updatedArticle.AccessLevel,
 		*updatedArticle.LastModifiedAt,
 		ID,
 		authorId,
@@ -91,11 +137,28 @@ func (r *articleRepositoryImpl) Update(ctx context.Context, ID int64, authorId i
 		return
 	}
 
+	payload := ArticleEvent{
+		ID:       ID,
+		AuthorID: authorId,
+		Title:    updatedArticle.Title,
+		Subtitle: updatedArticle.Subtitle,
+	}
+	err = r.record(ctx, tx.Tx, event.ArticleUpdated, ID, payload, *updatedArticle.LastModifiedAt)
+	if err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
 	return
 }
 func (r *articleRepositoryImpl) FindByID(ctx context.Context, ID int64) (article Article, err error) {
-	query := fmt.Sprintf(`SELECT id, title, subtitle, content, status, createdAt, publishedAt, lastModifiedAt, authorId FROM %s WHERE id = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, query)
+	query := fmt.Sprintf(`SELECT id, title, subtitle, content, language, accessLevel, status, createdAt, publishedAt, lastModifiedAt, authorId, slug FROM %s WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -113,11 +176,14 @@ func (r *articleRepositoryImpl) FindByID(ctx context.Context, ID int64) (article
 		&article.Title,
 		&article.Subtitle,
 		&article.Content,
+		&article.Language,
+		&article.AccessLevel,
 		&article.Status,
 		&article.CreatedAt,
 		&publishedAt,
 		&lastModifiedAt,
 		&article.Author.ID,
+		&article.Slug,
 	)
 
 	if err != nil {
@@ -137,8 +203,8 @@ func (r *articleRepositoryImpl) FindByID(ctx context.Context, ID int64) (article
 	return
 }
 func (r *articleRepositoryImpl) FindMany(ctx context.Context) (bunchOfArticles []Article, err error) {
-	query := fmt.Sprintf(`SELECT id, title, subtitle, content, status, createdAt, publishedAt, lastModifiedAt, authorId FROM %s`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, query)
+	query := fmt.Sprintf(`SELECT id, title, subtitle, content, language, accessLevel, status, createdAt, publishedAt, lastModifiedAt, authorId, slug FROM %s`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -165,11 +231,14 @@ func (r *articleRepositoryImpl) FindMany(ctx context.Context) (bunchOfArticles [
 			&article.Title,
 			&article.Subtitle,
 			&article.Content,
+			&article.Language,
+			&article.AccessLevel,
 			&article.Status,
 			&article.CreatedAt,
 			&publishedAt,
 			&lastModifiedAt,
 			&article.Author.ID,
+			&article.Slug,
 		)
 
 		if err != nil {
@@ -192,8 +261,8 @@ func (r *articleRepositoryImpl) FindMany(ctx context.Context) (bunchOfArticles [
 	return
 }
 func (r *articleRepositoryImpl) FindManySpecificProfile(ctx context.Context, authorId int64) (bunchOfArticles []Article, err error) {
-	query := fmt.Sprintf(`SELECT id, title, subtitle, content, status, createdAt, publishedAt, lastModifiedAt, authorId FROM %s WHERE authorId = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, query)
+	query := fmt.Sprintf(`SELECT id, title, subtitle, content, language, accessLevel, status, createdAt, publishedAt, lastModifiedAt, authorId, slug FROM %s WHERE authorId = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -220,11 +289,72 @@ func (r *articleRepositoryImpl) FindManySpecificProfile(ctx context.Context, aut
 			&article.Title,
 			&article.Subtitle,
 			&article.Content,
+			&article.Language,
+			&article.AccessLevel,
+			&article.Status,
+			&article.CreatedAt,
+			&publishedAt,
+			&lastModifiedAt,
+			&article.Author.ID,
+			&article.Slug,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrNotFound
+			return
+		}
+
+		if publishedAt.Valid {
+			article.PublishedAt = &publishedAt.Time
+		}
+
+		if lastModifiedAt.Valid {
+			article.LastModifiedAt = &lastModifiedAt.Time
+		}
+
+		bunchOfArticles = append(bunchOfArticles, article)
+	}
+
+	return
+}
+func (r *articleRepositoryImpl) FindManyByStatus(ctx context.Context, status ArticleStatus) (bunchOfArticles []Article, err error) {
+	query := fmt.Sprintf(`SELECT id, title, subtitle, content, language, accessLevel, status, createdAt, publishedAt, lastModifiedAt, authorId, slug FROM %s WHERE status = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, status)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		article := Article{}
+		var publishedAt sql.NullTime
+		var lastModifiedAt sql.NullTime
+
+		err = rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Subtitle,
+			&article.Content,
+			&article.Language,
+			&article.AccessLevel,
 			&article.Status,
 			&article.CreatedAt,
 			&publishedAt,
 			&lastModifiedAt,
 			&article.Author.ID,
+			&article.Slug,
 		)
 
 		if err != nil {
@@ -246,9 +376,69 @@ func (r *articleRepositoryImpl) FindManySpecificProfile(ctx context.Context, aut
 
 	return
 }
-func (r *articleRepositoryImpl) UpdateStatus(ctx context.Context, ID int64, authorId int64, updatedArticle Article) (err error) {
-	command := fmt.Sprintf(`UPDATE %s SET status = ?, publishedAt = ? WHERE id = ? AND authorId = ?`, r.tableName)
-	stmt, err := r.db.PrepareContext(ctx, command)
+
+// FindByIDs loads the articles with a single query, IDs without an article are left out.
+func (r *articleRepositoryImpl) FindByIDs(ctx context.Context, IDs []int64) (bunchOfArticles []Article, err error) {
+	if len(IDs) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(IDs)), ", ")
+	args := make([]interface{}, len(IDs))
+	for i, ID := range IDs {
+		args[i] = ID
+	}
+
+	query := fmt.Sprintf(`SELECT id, title, subtitle, content, language, accessLevel, status, createdAt, publishedAt, lastModifiedAt, authorId, slug FROM %s WHERE id IN (%s)`, r.tableName, placeholders)
+	err = r.queryEach(ctx, query, args, func(rows *sql.Rows) error {
+		article := Article{}
+		var publishedAt sql.NullTime
+		var lastModifiedAt sql.NullTime
+
+		err := rows.Scan(
+			&article.ID,
+			&article.Title,
+			&article.Subtitle,
+			&article.Content,
+			&article.Language,
+			&article.AccessLevel,
+			&article.Status,
+			&article.CreatedAt,
+			&publishedAt,
+			&lastModifiedAt,
+			&article.Author.ID,
+			&article.Slug,
+		)
+		if err != nil {
+			return err
+		}
+
+		if publishedAt.Valid {
+			article.PublishedAt = &publishedAt.Time
+		}
+
+		if lastModifiedAt.Valid {
+			article.LastModifiedAt = &lastModifiedAt.Time
+		}
+
+		bunchOfArticles = append(bunchOfArticles, article)
+		return nil
+	})
+
+	return
+}
+func (r *articleRepositoryImpl) UpdateStatus(ctx context.Context, ID int64, authorId int64, currentStatus ArticleStatus, updatedArticle Article) (err error) {
+	tx, err := database.Begin(ctx, r.db)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer tx.Rollback()
+
+	// Effects may rewrite the content on the way, an empty content leaves the stored one untouched.
+	command := fmt.Sprintf(`UPDATE %s SET status = ?, publishedAt = ?, lastModifiedAt = COALESCE(?, lastModifiedAt), content = COALESCE(NULLIF(?, ''), content) WHERE id = ? AND authorId = ? AND status = ?`, r.tableName)
+	stmt, err := tx.PrepareContext(ctx, command)
 	if err != nil {
 		log.Println(err)
 		err = exception.ErrInternalServer
@@ -260,8 +450,11 @@ func (r *articleRepositoryImpl) UpdateStatus(ctx context.Context, ID int64, auth
 		ctx,
 		updatedArticle.Status,
 		updatedArticle.PublishedAt,
+		updatedArticle.LastModifiedAt,
+		updatedArticle.Content,
 		ID,
 		authorId,
+		currentStatus,
 	)
 
 	if err != nil {
@@ -270,11 +463,143 @@ func (r *articleRepositoryImpl) UpdateStatus(ctx context.Context, ID int64, auth
 		return
 	}
 
+	// The status was changed by another request since the transition was checked against it.
 	rowsAffected, _ := result.RowsAffected()
 	if rowsAffected < 1 {
-		err = exception.ErrNotFound
+		err = exception.ErrConflicted
+		return
+	}
+
+	if eventType, ok := statusEvents[updatedArticle.Status]; ok {
+		payload := ArticleEvent{
+			ID:             ID,
+			AuthorID:       authorId,
+			Status:         updatedArticle.Status,
+			PreviousStatus: currentStatus,
+			PublishedAt:    updatedArticle.PublishedAt,
+		}
+		err = r.record(ctx, tx.Tx, eventType, ID, payload, time.Now())
+		if err != nil {
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
+	return
+}
+
+func (r *articleRepositoryImpl) record(ctx context.Context, tx *sql.Tx, eventType event.Type, ID int64, payload ArticleEvent, occurredAt time.Time) (err error) {
+	evt, err := event.New(eventType, ID, payload, occurredAt)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
 		return
 	}
 
+	return r.outbox.Add(ctx, tx, evt)
+}
+
+// SummarizeByAuthor computes the author dashboard in the database instead of loading every article.
+func (r *articleRepositoryImpl) SummarizeByAuthor(ctx context.Context, authorId int64, publishedSince time.Time, mostEditedLimit int) (summary AuthorSummary, err error) {
+	summary.CountByStatus = make(map[ArticleStatus]int)
+	for _, status := range articleStatuses {
+		summary.CountByStatus[status] = 0
+	}
+
+	err = r.queryEach(ctx, fmt.Sprintf(`SELECT status, COUNT(*) FROM %s WHERE authorId = ? GROUP BY status`, r.tableName), []interface{}{authorId}, func(rows *sql.Rows) error {
+		var status ArticleStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return err
+		}
+		summary.CountByStatus[status] = count
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	// A scheduled article keeps its planned time in publishedAt, only the released ones went live.
+	released := strings.TrimSuffix(strings.Repeat("?, ", len(releasedStatuses)), ", ")
+	releasedArgs := make([]interface{}, len(releasedStatuses))
+	for i, status := range releasedStatuses {
+		releasedArgs[i] = status
+	}
+
+	weekStart := "DATE_SUB(DATE(publishedAt), INTERVAL WEEKDAY(publishedAt) DAY)"
+	err = r.queryEach(ctx, fmt.Sprintf(`SELECT %s AS weekStart, COUNT(*) FROM %s WHERE authorId = ? AND publishedAt >= ? AND status IN (%s) GROUP BY weekStart ORDER BY weekStart`, weekStart, r.tableName, released), append([]interface{}{authorId, publishedSince}, releasedArgs...), func(rows *sql.Rows) error {
+		week := WeeklyPublishCount{}
+		if err := rows.Scan(&week.WeekStart, &week.Published); err != nil {
+			return err
+		}
+		summary.WeeklyPublished = append(summary.WeeklyPublished, week)
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	err = r.queryEach(ctx, fmt.Sprintf(`SELECT AVG(TIMESTAMPDIFF(SECOND, createdAt, publishedAt)) FROM %s WHERE authorId = ? AND publishedAt IS NOT NULL AND status IN (%s)`, r.tableName, released), append([]interface{}{authorId}, releasedArgs...), func(rows *sql.Rows) error {
+		var average sql.NullFloat64
+		if err := rows.Scan(&average); err != nil {
+			return err
+		}
+		if average.Valid {
+			summary.AverageDraftToPublishSeconds = &average.Float64
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	err = r.queryEach(ctx, fmt.Sprintf(`SELECT id, title, status, editCount, lastModifiedAt FROM %s WHERE authorId = ? AND editCount > 0 ORDER BY editCount DESC, lastModifiedAt DESC LIMIT ?`, r.tableName), []interface{}{authorId, mostEditedLimit}, func(rows *sql.Rows) error {
+		edited := EditedArticle{}
+		var lastModifiedAt sql.NullTime
+		if err := rows.Scan(&edited.ID, &edited.Title, &edited.Status, &edited.EditCount, &lastModifiedAt); err != nil {
+			return err
+		}
+		if lastModifiedAt.Valid {
+			edited.LastModifiedAt = &lastModifiedAt.Time
+		}
+		summary.MostEdited = append(summary.MostEdited, edited)
+		return nil
+	})
+
+	return
+}
+
+func (r *articleRepositoryImpl) queryEach(ctx context.Context, query string, args []interface{}, scan func(rows *sql.Rows) error) (err error) {
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
 	return
 }
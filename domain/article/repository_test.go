@@ -10,6 +10,8 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
 	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	"github.com/sangianpatrick/devoria-article-service/exception"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,12 +25,15 @@ func TestRepositorySave_Success(t *testing.T) {
 	ctx := context.TODO()
 
 	newArticle := article.Article{
-		ID:        1,
-		Title:     "test",
-		Subtitle:  "test",
-		Content:   "test",
-		Status:    article.ArticleStatusDraft,
-		CreatedAt: time.Now().In(location),
+		ID:          1,
+		Title:       "test",
+		Subtitle:    "test",
+		Slug:        "test",
+		Content:     "test",
+		Language:    "id",
+		AccessLevel: article.ArticleAccessLevelPublic,
+		Status:      article.ArticleStatusDraft,
+		CreatedAt:   time.Now().In(location),
 		Author: entity.Account{
 			ID: 1,
 		},
@@ -41,17 +46,26 @@ func TestRepositorySave_Success(t *testing.T) {
 		newArticle.Title,
 		newArticle.Subtitle,
 		newArticle.Content,
+		newArticle.Language,
+		newArticle.AccessLevel,
 		newArticle.Status,
 		newArticle.CreatedAt,
 		newArticle.Author.ID,
+		newArticle.Slug,
 	)
 
+	mock.ExpectBegin()
 	mock.ExpectPrepare(expectedCommand).
 		ExpectExec().
 		WithArgs(expectedArgs...).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectPrepare("INSERT INTO event_outbox").
+		ExpectExec().
+		WithArgs("article.created", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-	articleRepostitory := article.NewArticleRepository(db, tableName)
+	articleRepostitory := article.NewArticleRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
 	ID, err := articleRepostitory.Save(ctx, newArticle)
 
 	assert.NoError(t, err, "should not be error")
@@ -62,3 +76,116 @@ func TestRepositorySave_Success(t *testing.T) {
 	}
 }
 
+func TestRepositorySummarizeByAuthor_Success(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	ctx := context.TODO()
+	since := time.Date(2021, 6, 7, 0, 0, 0, 0, location)
+	lastModifiedAt := time.Date(2021, 8, 2, 9, 0, 0, 0, location)
+
+	mock.ExpectPrepare(`SELECT status, COUNT\(\*\) FROM article WHERE authorId = \? GROUP BY status`).ExpectQuery().
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("PUBLISHED", 4).AddRow("DRAFT", 1))
+	mock.ExpectPrepare(`SELECT DATE_SUB\(DATE\(publishedAt\), INTERVAL WEEKDAY\(publishedAt\) DAY\) AS weekStart, COUNT\(\*\) FROM article WHERE authorId = \? AND publishedAt >= \? AND status IN \(\?, \?, \?\)`).ExpectQuery().
+		WithArgs(int64(3), since, article.ArticleStatusPublished, article.ArticleStatusUnlisted, article.ArticleStatusArchived).
+		WillReturnRows(sqlmock.NewRows([]string{"weekStart", "count"}).AddRow(time.Date(2021, 7, 26, 0, 0, 0, 0, location), 2))
+	mock.ExpectPrepare(`SELECT AVG\(TIMESTAMPDIFF\(SECOND, createdAt, publishedAt\)\) FROM article WHERE authorId = \? AND publishedAt IS NOT NULL AND status IN \(\?, \?, \?\)`).ExpectQuery().
+		WithArgs(int64(3), article.ArticleStatusPublished, article.ArticleStatusUnlisted, article.ArticleStatusArchived).
+		WillReturnRows(sqlmock.NewRows([]string{"average"}).AddRow(7200.5))
+	mock.ExpectPrepare(`SELECT id, title, status, editCount, lastModifiedAt FROM article WHERE authorId = \? AND editCount > 0 ORDER BY editCount DESC`).ExpectQuery().
+		WithArgs(int64(3), 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status", "editCount", "lastModifiedAt"}).AddRow(int64(9), "Most edited", "PUBLISHED", 12, lastModifiedAt))
+
+	articleRepostitory := article.NewArticleRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	summary, err := articleRepostitory.SummarizeByAuthor(ctx, 3, since, 5)
+
+	assert.NoError(t, err, "should not be error")
+	assert.Equal(t, 4, summary.CountByStatus[article.ArticleStatusPublished])
+	assert.Equal(t, 0, summary.CountByStatus[article.ArticleStatusArchived])
+	assert.Len(t, summary.WeeklyPublished, 1)
+	assert.Equal(t, 7200.5, *summary.AverageDraftToPublishSeconds)
+	assert.Equal(t, 12, summary.MostEdited[0].EditCount)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositorySummarizeByAuthor_ScheduledIsNotPublished(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	since := time.Date(2021, 6, 7, 0, 0, 0, 0, location)
+
+	//The only article is scheduled, its publishedAt is the planned time
+	mock.ExpectPrepare(`SELECT status, COUNT\(\*\) FROM article`).ExpectQuery().
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("SCHEDULED", 1))
+	mock.ExpectPrepare(`AS weekStart, COUNT\(\*\) FROM article WHERE authorId = \? AND publishedAt >= \? AND status IN`).ExpectQuery().
+		WithArgs(int64(3), since, article.ArticleStatusPublished, article.ArticleStatusUnlisted, article.ArticleStatusArchived).
+		WillReturnRows(sqlmock.NewRows([]string{"weekStart", "count"}))
+	mock.ExpectPrepare(`SELECT AVG\(TIMESTAMPDIFF\(SECOND, createdAt, publishedAt\)\) FROM article WHERE authorId = \? AND publishedAt IS NOT NULL AND status IN`).ExpectQuery().
+		WithArgs(int64(3), article.ArticleStatusPublished, article.ArticleStatusUnlisted, article.ArticleStatusArchived).
+		WillReturnRows(sqlmock.NewRows([]string{"average"}).AddRow(nil))
+	mock.ExpectPrepare(`SELECT id, title, status, editCount, lastModifiedAt FROM article`).ExpectQuery().
+		WithArgs(int64(3), 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status", "editCount", "lastModifiedAt"}))
+
+	articleRepostitory := article.NewArticleRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	summary, err := articleRepostitory.SummarizeByAuthor(context.TODO(), 3, since, 5)
+
+	assert.NoError(t, err, "should not be error")
+	assert.Equal(t, 1, summary.CountByStatus[article.ArticleStatusScheduled])
+	assert.Empty(t, summary.WeeklyPublished, "a scheduled article should not count as published")
+	assert.Nil(t, summary.AverageDraftToPublishSeconds)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryFindByIDs_Success(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	createdAt := time.Date(2021, 8, 2, 9, 0, 0, 0, location)
+	columns := []string{"id", "title", "subtitle", "content", "language", "accessLevel", "status", "createdAt", "publishedAt", "lastModifiedAt", "authorId", "slug"}
+
+	mock.ExpectPrepare(`SELECT id, title, subtitle, content, language, accessLevel, status, createdAt, publishedAt, lastModifiedAt, authorId, slug FROM article WHERE id IN \(\?, \?\)`).ExpectQuery().
+		WithArgs(int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(int64(2), "Channels in golang", "", "content", "en", "PUBLIC", "PUBLISHED", createdAt, createdAt, nil, int64(1), "channels-in-golang"))
+
+	articleRepostitory := article.NewArticleRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	articles, err := articleRepostitory.FindByIDs(context.TODO(), []int64{2, 3})
+
+	assert.NoError(t, err, "should not be error")
+	assert.Len(t, articles, 1)
+	assert.Equal(t, int64(2), articles[0].ID)
+	assert.NotNil(t, articles[0].PublishedAt)
+	assert.Nil(t, articles[0].LastModifiedAt)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryUpdateStatus_ChangedMeanwhile(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	updated := article.Article{Status: article.ArticleStatusArchived}
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare(`UPDATE article SET status = \?, .* WHERE id = \? AND authorId = \? AND status = \?`).
+		ExpectExec().
+		WithArgs(article.ArticleStatusArchived, nil, nil, "", int64(1), int64(2), article.ArticleStatusPublished).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	articleRepostitory := article.NewArticleRepository(db, tableName, event.NewOutbox(db, "event_outbox"))
+	err := articleRepostitory.UpdateStatus(context.TODO(), 1, 2, article.ArticleStatusPublished, updated)
+
+	assert.Equal(t, exception.ErrConflicted, err)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
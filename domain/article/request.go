@@ -1,25 +1,154 @@
 package article
 
+import "time"
+
 // CreateArticleRequest is model for creating article.
 type CreateArticleRequest struct {
-	Title    string `json:"title" validate:"required"`
-	Subtitle string `json:"subtitle" validate:"required"`
-	Content  string `json:"content" validate:"required"`
+	Title       string             `json:"title" validate:"required"`
+	Subtitle    string             `json:"subtitle" validate:"required"`
+	Content     string             `json:"content" validate:"required"`
+	Language    string             `json:"language" validate:"omitempty,bcp47_language_tag"`
+	AccessLevel ArticleAccessLevel `json:"accessLevel" validate:"omitempty,oneof=PUBLIC MEMBERS"`
 }
 
 // EditArticleRequest is model for modified article.
 type EditArticleRequest struct {
-	ID       int64  `json:"id" validate:"required"`
-	Title    string `json:"title" validate:"required"`
-	Subtitle string `json:"subtitle" validate:"required"`
-	Content  string `json:"content" validate:"required"`
+	ID          int64              `json:"id" validate:"required"`
+	Title       string             `json:"title" validate:"required"`
+	Subtitle    string             `json:"subtitle" validate:"required"`
+	Content     string             `json:"content" validate:"required"`
+	AccessLevel ArticleAccessLevel `json:"accessLevel" validate:"omitempty,oneof=PUBLIC MEMBERS"`
 }
 
 type EditStatusArticleRequest struct {
-	ID     int64         `json:"id" validate:"required"`
-	Status ArticleStatus `json:"status" validate:"required"`
+	ID        int64         `json:"id" validate:"required"`
+	Status    ArticleStatus `json:"status" validate:"required"`
+	PublishAt *time.Time    `json:"publishAt"`
 }
 
 type GetOneArticleRequest struct {
-	ID     int64         `json:"id" validate:"required"`
-}
\ No newline at end of file
+	ID        int64    `json:"id" validate:"required"`
+	Languages []string `json:"-"`
+	Expand    []string `json:"-" validate:"dive,oneof=author"`
+}
+
+// ArticleSummaryRequest is model for the author dashboard.
+type ArticleSummaryRequest struct {
+	Weeks int `json:"-" validate:"omitempty,min=1,max=52"`
+}
+
+// ListArticleRequest is model for the article listings.
+type ListArticleRequest struct {
+	Languages []string `json:"-"`
+	Expand    []string `json:"-" validate:"dive,oneof=author"`
+}
+
+// CreatePreviewLinkRequest is model for issuing a draft preview link.
+type CreatePreviewLinkRequest struct {
+	ArticleID      int64 `json:"articleId" validate:"required"`
+	ExpiresInHours int   `json:"expiresInHours" validate:"omitempty,min=1,max=720"`
+}
+
+// RevokePreviewLinkRequest is model for revoking a draft preview link.
+type RevokePreviewLinkRequest struct {
+	ArticleID int64  `json:"articleId" validate:"required"`
+	LinkID    string `json:"linkId" validate:"required"`
+}
+
+// GetPreviewLinkViewsRequest is model for listing who viewed a preview link.
+type GetPreviewLinkViewsRequest struct {
+	ArticleID int64  `json:"articleId" validate:"required"`
+	LinkID    string `json:"linkId" validate:"required"`
+}
+
+// ViewPreviewRequest is model for opening a draft through its preview token.
+type ViewPreviewRequest struct {
+	Token     string `json:"token" validate:"required"`
+	Viewer    string `json:"viewer" validate:"max=100"`
+	IPAddress string `json:"ipAddress"`
+	UserAgent string `json:"userAgent"`
+}
+
+// GetReviewQueueRequest is model for listing reviews waiting on editors.
+type GetReviewQueueRequest struct {
+	Status ReviewStatus `json:"status" validate:"required,oneof=PENDING CLAIMED APPROVED CHANGES_REQUESTED"`
+}
+
+// GetReviewRequest is model for reading a review with its notes and decisions.
+type GetReviewRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+// ClaimReviewRequest is model for an editor taking a review.
+type ClaimReviewRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+// AddReviewNoteRequest is model for an inline note on the reviewed content.
+type AddReviewNoteRequest struct {
+	ReviewID int64  `json:"reviewId" validate:"required"`
+	Quote    string `json:"quote"`
+	Start    int    `json:"start" validate:"min=0"`
+	End      int    `json:"end" validate:"gtefield=Start"`
+	Body     string `json:"body" validate:"required"`
+}
+
+// DecideReviewRequest is model for approving or requesting changes on a review.
+type DecideReviewRequest struct {
+	ID      int64  `json:"id" validate:"required"`
+	Comment string `json:"comment"`
+}
+
+// UpsertTranslationRequest is model for adding or replacing a translation of an article.
+type UpsertTranslationRequest struct {
+	ArticleID int64  `json:"articleId" validate:"required"`
+	Language  string `json:"language" validate:"required,bcp47_language_tag"`
+	Title     string `json:"title" validate:"required"`
+	Subtitle  string `json:"subtitle" validate:"required"`
+	Content   string `json:"content" validate:"required"`
+}
+
+// DeleteTranslationRequest is model for removing a translation of an article.
+type DeleteTranslationRequest struct {
+	ArticleID int64  `json:"articleId" validate:"required"`
+	Language  string `json:"language" validate:"required,bcp47_language_tag"`
+}
+
+// GetTranslationsRequest is model for listing the translations of an article.
+type GetTranslationsRequest struct {
+	ArticleID int64 `json:"articleId" validate:"required"`
+}
+
+// GetRelatedArticlesRequest is model for finding the articles similar to a published one.
+type GetRelatedArticlesRequest struct {
+	ID    int64 `json:"id" validate:"required"`
+	Limit int   `json:"limit" validate:"min=0,max=20"`
+}
+
+// GetBacklinksRequest is model for finding the published articles linking to an article.
+type GetBacklinksRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+// AnalyzeArticleRequest is model for scoring the writing of an article.
+type AnalyzeArticleRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+// PinArticleRequest is model for pinning an article to a homepage slot.
+type PinArticleRequest struct {
+	ArticleID int64     `json:"articleId" validate:"required"`
+	Position  int       `json:"position" validate:"required,min=1,max=20"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+}
+
+// UnpinArticleRequest is model for removing an article from the homepage slots.
+type UnpinArticleRequest struct {
+	ArticleID int64 `json:"articleId" validate:"required"`
+}
+
+// GetTrendingArticlesRequest is model for the trending ranking.
+type GetTrendingArticlesRequest struct {
+	Window TrendingWindow `json:"window" validate:"required,oneof=24h 7d"`
+	Limit  int            `json:"limit" validate:"min=0,max=50"`
+}
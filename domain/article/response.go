@@ -11,14 +11,100 @@ type CreateArticleResponse struct {
 	Profile entity.Account `json:"profile"`
 }
 
+type CreatedArticleResponse struct {
+	Article
+	Duplicates []DuplicateMatch `json:"duplicates,omitempty"`
+	Warnings   []string         `json:"warnings,omitempty"`
+}
+
+type EditArticleResponse struct {
+	EditArticleRequest
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type UpsertTranslationResponse struct {
+	ArticleTranslation
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 type GetArticleResponse struct {
+	ID             int64              `json:"id"`
+	Title          string             `json:"title"`
+	Subtitle       string             `json:"subtitle"`
+	Content        string             `json:"content"`
+	Language       string             `json:"language"`
+	AccessLevel    ArticleAccessLevel `json:"accessLevel"`
+	Locked         bool               `json:"locked"`
+	Status         ArticleStatus      `json:"status"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	PublishedAt    *time.Time         `json:"publishedAt"`
+	LastModifiedAt *time.Time         `json:"lastModifiedAt"`
+	AuthorID       int64              `json:"authorId"`
+	Author         *AuthorProfile     `json:"author,omitempty"`
+	Slug           string             `json:"slug"`
+}
+
+// AuthorProfile is the public profile of an article author.
+type AuthorProfile struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Handle    string `json:"handle,omitempty"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+}
+
+// ExpandedArticleResponse is an article with its author profile embedded in place of the bare author.
+type ExpandedArticleResponse struct {
+	Article
+	Author *AuthorProfile `json:"author"`
+}
+
+type RelatedArticleResponse struct {
+	GetArticleResponse
+	Score float64 `json:"score"`
+}
+
+type TrendingArticleResponse struct {
+	GetArticleResponse
+	Score float64 `json:"score"`
+}
+
+type FeaturedArticleResponse struct {
+	GetArticleResponse
+	Pinned    bool       `json:"pinned"`
+	Position  int        `json:"position,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type EditStatusArticleResponse struct {
+	ID             int64         `json:"id"`
+	Status         ArticleStatus `json:"status"`
+	PreviousStatus ArticleStatus `json:"previousStatus"`
+	PublishedAt    *time.Time    `json:"publishedAt"`
+	Warnings       []string      `json:"warnings,omitempty"`
+}
+
+type CreatePreviewLinkResponse struct {
+	ID        string    `json:"id"`
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type PreviewArticleResponse struct {
 	ID             int64         `json:"id"`
 	Title          string        `json:"title"`
 	Subtitle       string        `json:"subtitle"`
 	Content        string        `json:"content"`
 	Status         ArticleStatus `json:"status"`
 	CreatedAt      time.Time     `json:"createdAt"`
-	PublishedAt    *time.Time    `json:"publishedAt"`
 	LastModifiedAt *time.Time    `json:"lastModifiedAt"`
 	AuthorID       int64         `json:"authorId"`
-}
\ No newline at end of file
+	ReadOnly       bool          `json:"readOnly"`
+	ExpiresAt      time.Time     `json:"expiresAt"`
+}
+
+type ReviewDetailResponse struct {
+	ArticleReview
+	Notes     []ReviewNote     `json:"notes"`
+	Decisions []ReviewDecision `json:"decisions"`
+}
@@ -0,0 +1,192 @@
+package article
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type ReviewHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  ReviewUsecase
+}
+
+func NewReviewHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase ReviewUsecase,
+) {
+	handler := &ReviewHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/reviews", bearerAuthMiddleware.VerifyBearer(handler.GetQueue)).Methods(http.MethodGet)
+	router.HandleFunc("/v1/reviews/{id:[0-9]+}", bearerAuthMiddleware.VerifyBearer(handler.GetOne)).Methods(http.MethodGet)
+	//Post
+	router.HandleFunc("/v1/reviews/{id:[0-9]+}/claim", bearerAuthMiddleware.VerifyBearer(handler.Claim)).Methods(http.MethodPost)
+	router.HandleFunc("/v1/reviews/{id:[0-9]+}/notes", bearerAuthMiddleware.VerifyBearer(handler.AddNote)).Methods(http.MethodPost)
+	router.HandleFunc("/v1/reviews/{id:[0-9]+}/approve", bearerAuthMiddleware.VerifyBearer(handler.Approve)).Methods(http.MethodPost)
+	router.HandleFunc("/v1/reviews/{id:[0-9]+}/request-changes", bearerAuthMiddleware.VerifyBearer(handler.RequestChanges)).Methods(http.MethodPost)
+}
+
+func (handler *ReviewHTTPHandler) GetQueue(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetReviewQueueRequest
+	var ctx = r.Context()
+
+	params.Status = ReviewStatus(r.URL.Query().Get("status"))
+	if params.Status == "" {
+		params.Status = ReviewStatusPending
+	}
+
+	err := handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetQueue(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *ReviewHTTPHandler) GetOne(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetReviewRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetOne(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *ReviewHTTPHandler) Claim(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params ClaimReviewRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Claim(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *ReviewHTTPHandler) AddNote(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params AddReviewNoteRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ReviewID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.AddNote(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *ReviewHTTPHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	params, resp := handler.decideParams(r)
+	if resp != nil {
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Approve(r.Context(), params)
+	resp.JSON(w)
+}
+
+func (handler *ReviewHTTPHandler) RequestChanges(w http.ResponseWriter, r *http.Request) {
+	params, resp := handler.decideParams(r)
+	if resp != nil {
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.RequestChanges(r.Context(), params)
+	resp.JSON(w)
+}
+
+func (handler *ReviewHTTPHandler) decideParams(r *http.Request) (params DecideReviewRequest, resp response.Response) {
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	// The comment is optional when approving, so is the body.
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil && err != io.EOF {
+		return params, response.Error(response.StatusUnprocessabelEntity, nil, err)
+	}
+
+	params.ID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return params, response.Error(response.StatusUnprocessabelEntity, nil, err)
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		return params, response.Error(response.StatusInvalidPayload, nil, err)
+	}
+
+	return params, nil
+}
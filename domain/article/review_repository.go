@@ -0,0 +1,360 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type ReviewRepository interface {
+	Save(ctx context.Context, review ArticleReview) (ID int64, err error)
+	FindByID(ctx context.Context, ID int64) (review ArticleReview, err error)
+	FindLatestByArticle(ctx context.Context, articleID int64) (review ArticleReview, err error)
+	FindManyByStatus(ctx context.Context, status ReviewStatus) (reviews []ArticleReview, err error)
+	UpdateStatus(ctx context.Context, ID int64, currentStatus ReviewStatus, updatedReview ArticleReview) (err error)
+	SaveNote(ctx context.Context, note ReviewNote) (ID int64, err error)
+	FindNotes(ctx context.Context, reviewID int64) (notes []ReviewNote, err error)
+	SaveDecision(ctx context.Context, decision ReviewDecision) (ID int64, err error)
+	FindDecisions(ctx context.Context, reviewID int64) (decisions []ReviewDecision, err error)
+}
+
+type reviewRepositoryImpl struct {
+	db                *sql.DB
+	tableName         string
+	noteTableName     string
+	decisionTableName string
+}
+
+func NewReviewRepository(db *sql.DB, tableName string, noteTableName string, decisionTableName string) ReviewRepository {
+	return &reviewRepositoryImpl{
+		db:                db,
+		tableName:         tableName,
+		noteTableName:     noteTableName,
+		decisionTableName: decisionTableName,
+	}
+}
+
+func (r *reviewRepositoryImpl) Save(ctx context.Context, review ArticleReview) (ID int64, err error) {
+	command := fmt.Sprintf("INSERT INTO %s (articleId, submittedBy, status, submittedAt) VALUES (?, ?, ?, ?)", r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		review.ArticleID,
+		review.SubmittedBy,
+		review.Status,
+		review.SubmittedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	ID, _ = result.LastInsertId()
+
+	return
+}
+
+func (r *reviewRepositoryImpl) scan(scanner interface{ Scan(dest ...interface{}) error }) (review ArticleReview, err error) {
+	var reviewerID sql.NullInt64
+	var claimedAt sql.NullTime
+	var decidedAt sql.NullTime
+
+	err = scanner.Scan(
+		&review.ID,
+		&review.ArticleID,
+		&review.SubmittedBy,
+		&reviewerID,
+		&review.Status,
+		&review.SubmittedAt,
+		&claimedAt,
+		&decidedAt,
+	)
+
+	if err != nil {
+		return
+	}
+
+	if reviewerID.Valid {
+		review.ReviewerID = &reviewerID.Int64
+	}
+
+	if claimedAt.Valid {
+		review.ClaimedAt = &claimedAt.Time
+	}
+
+	if decidedAt.Valid {
+		review.DecidedAt = &decidedAt.Time
+	}
+
+	return
+}
+
+func (r *reviewRepositoryImpl) FindByID(ctx context.Context, ID int64) (review ArticleReview, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, submittedBy, reviewerId, status, submittedAt, claimedAt, decidedAt FROM %s WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	review, err = r.scan(stmt.QueryRowContext(ctx, ID))
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *reviewRepositoryImpl) FindLatestByArticle(ctx context.Context, articleID int64) (review ArticleReview, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, submittedBy, reviewerId, status, submittedAt, claimedAt, decidedAt FROM %s WHERE articleId = ? ORDER BY submittedAt DESC, id DESC LIMIT 1`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	review, err = r.scan(stmt.QueryRowContext(ctx, articleID))
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *reviewRepositoryImpl) FindManyByStatus(ctx context.Context, status ReviewStatus) (reviews []ArticleReview, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, submittedBy, reviewerId, status, submittedAt, claimedAt, decidedAt FROM %s WHERE status = ? ORDER BY submittedAt ASC`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, status)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		review, err := r.scan(rows)
+		if err != nil {
+			log.Println(err)
+			return reviews, exception.ErrInternalServer
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	return
+}
+
+func (r *reviewRepositoryImpl) UpdateStatus(ctx context.Context, ID int64, currentStatus ReviewStatus, updatedReview ArticleReview) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET reviewerId = ?, status = ?, claimedAt = ?, decidedAt = ? WHERE id = ? AND status = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		updatedReview.ReviewerID,
+		updatedReview.Status,
+		updatedReview.ClaimedAt,
+		updatedReview.DecidedAt,
+		ID,
+		currentStatus,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *reviewRepositoryImpl) SaveNote(ctx context.Context, note ReviewNote) (ID int64, err error) {
+	command := fmt.Sprintf("INSERT INTO %s (reviewId, reviewerId, quote, startOffset, endOffset, body, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?)", r.noteTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		note.ReviewID,
+		note.ReviewerID,
+		note.Quote,
+		note.Start,
+		note.End,
+		note.Body,
+		note.CreatedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	ID, _ = result.LastInsertId()
+
+	return
+}
+
+func (r *reviewRepositoryImpl) FindNotes(ctx context.Context, reviewID int64) (notes []ReviewNote, err error) {
+	query := fmt.Sprintf(`SELECT id, reviewId, reviewerId, quote, startOffset, endOffset, body, createdAt FROM %s WHERE reviewId = ? ORDER BY startOffset ASC, id ASC`, r.noteTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, reviewID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		note := ReviewNote{}
+
+		err = rows.Scan(
+			&note.ID,
+			&note.ReviewID,
+			&note.ReviewerID,
+			&note.Quote,
+			&note.Start,
+			&note.End,
+			&note.Body,
+			&note.CreatedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		notes = append(notes, note)
+	}
+
+	return
+}
+
+func (r *reviewRepositoryImpl) SaveDecision(ctx context.Context, decision ReviewDecision) (ID int64, err error) {
+	command := fmt.Sprintf("INSERT INTO %s (reviewId, reviewerId, decision, comment, decidedAt) VALUES (?, ?, ?, ?, ?)", r.decisionTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		decision.ReviewID,
+		decision.ReviewerID,
+		decision.Decision,
+		decision.Comment,
+		decision.DecidedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	ID, _ = result.LastInsertId()
+
+	return
+}
+
+func (r *reviewRepositoryImpl) FindDecisions(ctx context.Context, reviewID int64) (decisions []ReviewDecision, err error) {
+	query := fmt.Sprintf(`SELECT id, reviewId, reviewerId, decision, comment, decidedAt FROM %s WHERE reviewId = ? ORDER BY decidedAt ASC, id ASC`, r.decisionTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, reviewID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		decision := ReviewDecision{}
+
+		err = rows.Scan(
+			&decision.ID,
+			&decision.ReviewID,
+			&decision.ReviewerID,
+			&decision.Decision,
+			&decision.Comment,
+			&decision.DecidedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return
+}
@@ -0,0 +1,353 @@
+package article
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type ReviewUsecase interface {
+	GetQueue(ctx context.Context, params GetReviewQueueRequest) (resp response.Response)
+	GetOne(ctx context.Context, params GetReviewRequest) (resp response.Response)
+	Claim(ctx context.Context, params ClaimReviewRequest) (resp response.Response)
+	AddNote(ctx context.Context, params AddReviewNoteRequest) (resp response.Response)
+	Approve(ctx context.Context, params DecideReviewRequest) (resp response.Response)
+	RequestChanges(ctx context.Context, params DecideReviewRequest) (resp response.Response)
+}
+
+type reviewUsecaseImpl struct {
+	location     *time.Location
+	repository   ReviewRepository
+	articleRepo  ArticleRepository
+	accountRepo  account.AccountRepository
+	stateMachine *ArticleStateMachine
+}
+
+func NewReviewUsecase(
+	location *time.Location,
+	repository ReviewRepository,
+	articleRepo ArticleRepository,
+	accountRepo account.AccountRepository,
+	stateMachine *ArticleStateMachine,
+) ReviewUsecase {
+	return &reviewUsecaseImpl{
+		location:     location,
+		repository:   repository,
+		articleRepo:  articleRepo,
+		accountRepo:  accountRepo,
+		stateMachine: stateMachine,
+	}
+}
+
+func (u *reviewUsecaseImpl) findAccount(ctx context.Context) (account entity.Account, resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return account, response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return account, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return account, nil
+}
+
+func (u *reviewUsecaseImpl) findEditor(ctx context.Context) (account entity.Account, resp response.Response) {
+	account, resp = u.findAccount(ctx)
+	if resp != nil {
+		return
+	}
+
+	if !account.HasRole(entity.AccountRoleEditor, entity.AccountRoleAdmin) {
+		return account, response.Error(response.StatusForbiddend, nil, exception.ErrUnauthorized)
+	}
+
+	return account, nil
+}
+
+// findClaimedReview returns the review and its article only when the editor holds the claim on it.
+func (u *reviewUsecaseImpl) findClaimedReview(ctx context.Context, ID int64, editor entity.Account) (review ArticleReview, article Article, resp response.Response) {
+	review, err := u.repository.FindByID(ctx, ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return review, article, response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return review, article, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if review.Status != ReviewStatusClaimed || review.ReviewerID == nil || *review.ReviewerID != editor.ID {
+		return review, article, response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+	}
+
+	article, err = u.articleRepo.FindByID(ctx, review.ArticleID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return review, article, response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return review, article, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return review, article, nil
+}
+
+func (u *reviewUsecaseImpl) decide(ctx context.Context, review ArticleReview, editor entity.Account, decisionType ReviewDecisionType, comment string) (resp response.Response) {
+	decision := ReviewDecision{}
+	decision.ReviewID = review.ID
+	decision.ReviewerID = editor.ID
+	decision.Decision = decisionType
+	decision.Comment = comment
+	decision.DecidedAt = time.Now().In(u.location)
+
+	_, err := u.repository.SaveDecision(ctx, decision)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return nil
+}
+
+func (u *reviewUsecaseImpl) GetQueue(ctx context.Context, params GetReviewQueueRequest) (resp response.Response) {
+	_, resp = u.findEditor(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	reviews, err := u.repository.FindManyByStatus(ctx, params.Status)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, reviews)
+}
+
+func (u *reviewUsecaseImpl) GetOne(ctx context.Context, params GetReviewRequest) (resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	review, err := u.repository.FindByID(ctx, params.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	//Only editors and the author who submitted it can read the review
+	if review.SubmittedBy != account.ID && !account.HasRole(entity.AccountRoleEditor, entity.AccountRoleAdmin) {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrUnauthorized)
+	}
+
+	notes, err := u.repository.FindNotes(ctx, review.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	decisions, err := u.repository.FindDecisions(ctx, review.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	reviewDetailResponse := ReviewDetailResponse{}
+	reviewDetailResponse.ArticleReview = review
+	reviewDetailResponse.Notes = notes
+	reviewDetailResponse.Decisions = decisions
+
+	return response.Success(response.StatusOK, reviewDetailResponse)
+}
+
+func (u *reviewUsecaseImpl) Claim(ctx context.Context, params ClaimReviewRequest) (resp response.Response) {
+	editor, resp := u.findEditor(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	review, err := u.repository.FindByID(ctx, params.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	article, err := u.articleRepo.FindByID(ctx, review.ArticleID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	//Editors can't sign off their own writing
+	if article.Author.ID == editor.ID {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+	}
+
+	if review.Status != ReviewStatusPending || article.Status != ArticleStatusInReview {
+		return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+	}
+
+	claimedAt := time.Now().In(u.location)
+
+	updatedReview := review
+	updatedReview.ReviewerID = &editor.ID
+	updatedReview.Status = ReviewStatusClaimed
+	updatedReview.ClaimedAt = &claimedAt
+
+	//The status check in the update makes sure only one editor wins the claim
+	err = u.repository.UpdateStatus(ctx, review.ID, ReviewStatusPending, updatedReview)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if resp = u.decide(ctx, updatedReview, editor, ReviewDecisionClaimed, ""); resp != nil {
+		return resp
+	}
+
+	return response.Success(response.StatusOK, updatedReview)
+}
+
+func (u *reviewUsecaseImpl) AddNote(ctx context.Context, params AddReviewNoteRequest) (resp response.Response) {
+	editor, resp := u.findEditor(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	review, article, resp := u.findClaimedReview(ctx, params.ReviewID, editor)
+	if resp != nil {
+		return resp
+	}
+
+	content := []rune(article.Content)
+	if params.End > len(content) {
+		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+	}
+
+	note := ReviewNote{}
+	note.ReviewID = review.ID
+	note.ReviewerID = editor.ID
+	note.Quote = params.Quote
+	note.Start = params.Start
+	note.End = params.End
+	note.Body = params.Body
+	note.CreatedAt = time.Now().In(u.location)
+
+	if note.Quote == "" {
+		note.Quote = string(content[note.Start:note.End])
+	}
+
+	ID, err := u.repository.SaveNote(ctx, note)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+	note.ID = ID
+
+	return response.Success(response.StatusCreated, note)
+}
+
+func (u *reviewUsecaseImpl) Approve(ctx context.Context, params DecideReviewRequest) (resp response.Response) {
+	editor, resp := u.findEditor(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	review, article, resp := u.findClaimedReview(ctx, params.ID, editor)
+	if resp != nil {
+		return resp
+	}
+
+	if article.Status != ArticleStatusInReview {
+		return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+	}
+
+	decidedAt := time.Now().In(u.location)
+
+	updatedReview := review
+	updatedReview.Status = ReviewStatusApproved
+	updatedReview.DecidedAt = &decidedAt
+
+	err := u.repository.UpdateStatus(ctx, review.ID, ReviewStatusClaimed, updatedReview)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if resp = u.decide(ctx, updatedReview, editor, ReviewDecisionApproved, params.Comment); resp != nil {
+		return resp
+	}
+
+	return response.Success(response.StatusOK, updatedReview)
+}
+
+func (u *reviewUsecaseImpl) RequestChanges(ctx context.Context, params DecideReviewRequest) (resp response.Response) {
+	editor, resp := u.findEditor(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	//The author needs to know what to change
+	if params.Comment == "" {
+		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+	}
+
+	review, article, resp := u.findClaimedReview(ctx, params.ID, editor)
+	if resp != nil {
+		return resp
+	}
+
+	decidedAt := time.Now().In(u.location)
+
+	updatedReview := review
+	updatedReview.Status = ReviewStatusChangesRequested
+	updatedReview.DecidedAt = &decidedAt
+
+	err := u.repository.UpdateStatus(ctx, review.ID, ReviewStatusClaimed, updatedReview)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if resp = u.decide(ctx, updatedReview, editor, ReviewDecisionChangesRequested, params.Comment); resp != nil {
+		return resp
+	}
+
+	//Hand the article back to the author so it can be edited and submitted again
+	if article.Status == ArticleStatusInReview {
+		transition := &StatusTransition{
+			From:    article.Status,
+			To:      ArticleStatusDraft,
+			Article: article,
+			Actor:   editor,
+			At:      decidedAt,
+		}
+
+		err = u.stateMachine.Apply(ctx, transition)
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+
+		//A conflict means the article has left the review by now, there is nothing to hand back
+		err = u.articleRepo.UpdateStatus(ctx, article.ID, article.Author.ID, transition.From, transition.Updated)
+		if err != nil && err != exception.ErrConflicted {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+
+		if err == nil {
+			u.stateMachine.Complete(ctx, transition)
+		}
+	}
+
+	return response.Success(response.StatusOK, updatedReview)
+}
@@ -0,0 +1,191 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+var (
+	dataEditor = entity.Account{
+		ID:        2,
+		Email:     "editor@mail.c",
+		FirstName: "Frida",
+		LastName:  "Kahlo",
+		Role:      entity.AccountRoleEditor,
+	}
+	dataReviewedArticle = article.Article{
+		ID:      1,
+		Title:   "test",
+		Content: "some content to review",
+		Status:  article.ArticleStatusInReview,
+		Author: entity.Account{
+			ID: 1,
+		},
+	}
+)
+
+func TestReviewUsecaseClaim_Success(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(dataEditor, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(dataReviewedArticle, nil)
+
+	reviewRepo := new(articleMocks.ReviewRepository)
+	reviewRepo.On("FindByID", mock.Anything, int64(1)).Return(article.ArticleReview{ID: 1, ArticleID: 1, Status: article.ReviewStatusPending}, nil)
+	reviewRepo.On("UpdateStatus", mock.Anything, int64(1), article.ReviewStatusPending, mock.AnythingOfType("article.ArticleReview")).Return(nil)
+	reviewRepo.On("SaveDecision", mock.Anything, mock.AnythingOfType("article.ReviewDecision")).Return(int64(1), nil)
+
+	u := article.NewReviewUsecase(location, reviewRepo, articleRepo, accountRepo, article.NewArticleStateMachine())
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, dataEditor.Email)
+
+	resp := u.Claim(ctx, article.ClaimReviewRequest{ID: 1})
+	assert.NoError(t, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	reviewRepo.AssertExpectations(t)
+}
+
+func TestReviewUsecaseClaim_NotEditor(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 3, Role: entity.AccountRoleAuthor}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	reviewRepo := new(articleMocks.ReviewRepository)
+
+	u := article.NewReviewUsecase(location, reviewRepo, articleRepo, accountRepo, article.NewArticleStateMachine())
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "author@mail.c")
+
+	resp := u.Claim(ctx, article.ClaimReviewRequest{ID: 1})
+	assert.Error(t, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	reviewRepo.AssertExpectations(t)
+}
+
+func TestReviewUsecaseApprove_NotClaimedByEditor(t *testing.T) {
+	otherEditorID := int64(9)
+
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(dataEditor, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+
+	reviewRepo := new(articleMocks.ReviewRepository)
+	reviewRepo.On("FindByID", mock.Anything, int64(1)).Return(article.ArticleReview{ID: 1, ArticleID: 1, Status: article.ReviewStatusClaimed, ReviewerID: &otherEditorID}, nil)
+
+	u := article.NewReviewUsecase(location, reviewRepo, articleRepo, accountRepo, article.NewArticleStateMachine())
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, dataEditor.Email)
+
+	resp := u.Approve(ctx, article.DecideReviewRequest{ID: 1})
+	assert.Equal(t, exception.ErrConflicted, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	reviewRepo.AssertExpectations(t)
+}
+
+func TestReviewUsecaseRequestChanges_ReturnsArticleToDraft(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(dataEditor, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(dataReviewedArticle, nil)
+	articleRepo.On("UpdateStatus", mock.Anything, int64(1), int64(1), article.ArticleStatusInReview, mock.MatchedBy(func(updated article.Article) bool {
+		return updated.Status == article.ArticleStatusDraft
+	})).Return(nil)
+
+	reviewRepo := new(articleMocks.ReviewRepository)
+	reviewRepo.On("FindByID", mock.Anything, int64(1)).Return(article.ArticleReview{ID: 1, ArticleID: 1, Status: article.ReviewStatusClaimed, ReviewerID: &dataEditor.ID}, nil)
+	reviewRepo.On("UpdateStatus", mock.Anything, int64(1), article.ReviewStatusClaimed, mock.AnythingOfType("article.ArticleReview")).Return(nil)
+	reviewRepo.On("SaveDecision", mock.Anything, mock.AnythingOfType("article.ReviewDecision")).Return(int64(1), nil)
+
+	u := article.NewReviewUsecase(location, reviewRepo, articleRepo, accountRepo, article.NewArticleStateMachine())
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, dataEditor.Email)
+
+	resp := u.RequestChanges(ctx, article.DecideReviewRequest{ID: 1, Comment: "tighten the intro"})
+	assert.NoError(t, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	reviewRepo.AssertExpectations(t)
+}
+
+func TestReviewWorkflow_PublishRequiresApproval(t *testing.T) {
+	reviewRepo := new(articleMocks.ReviewRepository)
+	reviewRepo.On("FindLatestByArticle", mock.Anything, int64(1)).Return(article.ArticleReview{}, exception.ErrNotFound)
+
+	m := article.NewArticleStateMachine()
+	article.NewReviewWorkflow(reviewRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusDraft,
+		To:      article.ArticleStatusPublished,
+		Article: article.Article{ID: 1, Status: article.ArticleStatusDraft},
+		At:      time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	_, ok := err.(*article.TransitionRejectedError)
+	assert.True(t, ok, "should be rejected")
+
+	reviewRepo.AssertExpectations(t)
+}
+
+func TestReviewWorkflow_PublishAfterApproval(t *testing.T) {
+	decidedAt := time.Now().In(location)
+
+	reviewRepo := new(articleMocks.ReviewRepository)
+	reviewRepo.On("FindLatestByArticle", mock.Anything, int64(1)).Return(article.ArticleReview{ID: 1, Status: article.ReviewStatusApproved, DecidedAt: &decidedAt}, nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewReviewWorkflow(reviewRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusInReview,
+		To:      article.ArticleStatusPublished,
+		Article: dataReviewedArticle,
+		At:      time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+	assert.NoError(t, err)
+
+	reviewRepo.AssertExpectations(t)
+}
+
+func TestReviewWorkflow_OpensReviewAfterSubmissionIsSaved(t *testing.T) {
+	reviewRepo := new(articleMocks.ReviewRepository)
+	reviewRepo.On("Save", mock.Anything, mock.AnythingOfType("article.ArticleReview")).Return(int64(1), nil)
+
+	m := article.NewArticleStateMachine()
+	article.NewReviewWorkflow(reviewRepo).Register(m)
+
+	transition := &article.StatusTransition{
+		From:    article.ArticleStatusDraft,
+		To:      article.ArticleStatusInReview,
+		Article: article.Article{ID: 1, Status: article.ArticleStatusDraft},
+		At:      time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+	assert.NoError(t, err)
+	reviewRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+
+	m.Complete(context.TODO(), transition)
+	reviewRepo.AssertNumberOfCalls(t, "Save", 1)
+	assert.Empty(t, transition.Warnings)
+}
@@ -0,0 +1,74 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+// ReviewWorkflow plugs the editorial review into the article lifecycle.
+type ReviewWorkflow struct {
+	repository ReviewRepository
+}
+
+// NewReviewWorkflow is a constructor.
+func NewReviewWorkflow(repository ReviewRepository) *ReviewWorkflow {
+	return &ReviewWorkflow{
+		repository: repository,
+	}
+}
+
+// Register opens a review once a submission is saved and
+// requires an approved review before an unreleased article goes live.
+func (w *ReviewWorkflow) Register(m *ArticleStateMachine) {
+	m.AfterEnter(ArticleStatusInReview, w.openReview)
+	m.Guard(ArticleStatusPublished, w.requireApproval)
+	m.Guard(ArticleStatusScheduled, w.requireApproval)
+}
+
+func (w *ReviewWorkflow) openReview(ctx context.Context, transition *StatusTransition) (err error) {
+	review := ArticleReview{}
+	review.ArticleID = transition.Article.ID
+	review.SubmittedBy = transition.Actor.ID
+	review.Status = ReviewStatusPending
+	review.SubmittedAt = transition.At
+
+	_, err = w.repository.Save(ctx, review)
+	if err != nil {
+		transition.Warnings = append(transition.Warnings, "the editorial review could not be opened, move the article back to draft and submit it again")
+	}
+
+	return
+}
+
+func (w *ReviewWorkflow) requireApproval(ctx context.Context, transition *StatusTransition) (err error) {
+	// Scheduled articles were approved when they got scheduled, and
+	// anything that has been live before doesn't need a new sign-off.
+	if transition.From != ArticleStatusDraft && transition.From != ArticleStatusInReview {
+		return
+	}
+
+	review, err := w.repository.FindLatestByArticle(ctx, transition.Article.ID)
+	if err != nil && err != exception.ErrNotFound {
+		return
+	}
+
+	if err == exception.ErrNotFound || review.Status != ReviewStatusApproved {
+		return &TransitionRejectedError{
+			From:   transition.From,
+			To:     transition.To,
+			Reason: "article needs an approved editorial review",
+		}
+	}
+
+	lastModifiedAt := transition.Article.LastModifiedAt
+	if lastModifiedAt != nil && review.DecidedAt != nil && lastModifiedAt.After(*review.DecidedAt) {
+		return &TransitionRejectedError{
+			From:   transition.From,
+			To:     transition.To,
+			Reason: "article was edited after it got approved, submit it for review again",
+		}
+	}
+
+	return nil
+}
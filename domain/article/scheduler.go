@@ -0,0 +1,98 @@
+package article
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+)
+
+// ArticleScheduler publishes scheduled articles once their publish time has come.
+type ArticleScheduler struct {
+	interval     time.Duration
+	location     *time.Location
+	stateMachine *ArticleStateMachine
+	repository   ArticleRepository
+	accountRepo  account.AccountRepository
+}
+
+// NewArticleScheduler is a constructor.
+func NewArticleScheduler(
+	interval time.Duration,
+	location *time.Location,
+	stateMachine *ArticleStateMachine,
+	repository ArticleRepository,
+	accountRepo account.AccountRepository,
+) *ArticleScheduler {
+	return &ArticleScheduler{
+		interval:     interval,
+		location:     location,
+		stateMachine: stateMachine,
+		repository:   repository,
+		accountRepo:  accountRepo,
+	}
+}
+
+// Run checks for due articles on every tick until the context is done.
+func (s *ArticleScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.PublishDue(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// PublishDue moves every scheduled article whose publish time has passed to PUBLISHED.
+// It goes through the state machine so the same guards and hooks apply as for a manual publish.
+func (s *ArticleScheduler) PublishDue(ctx context.Context) (published int, err error) {
+	articles, err := s.repository.FindManyByStatus(ctx, ArticleStatusScheduled)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().In(s.location)
+
+	for _, article := range articles {
+		if article.PublishedAt == nil || article.PublishedAt.After(now) {
+			continue
+		}
+
+		author, err := s.accountRepo.FindByID(ctx, article.Author.ID)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		transition := &StatusTransition{
+			From:    article.Status,
+			To:      ArticleStatusPublished,
+			Article: article,
+			Actor:   author,
+			At:      now,
+		}
+
+		if err := s.stateMachine.Apply(ctx, transition); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		if err := s.repository.UpdateStatus(ctx, article.ID, article.Author.ID, transition.From, transition.Updated); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		s.stateMachine.Complete(ctx, transition)
+		published++
+	}
+
+	return
+}
@@ -0,0 +1,249 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+)
+
+// StatusTransition describes a single change of an article status while it is being applied.
+type StatusTransition struct {
+	From    ArticleStatus
+	To      ArticleStatus
+	Article Article
+	// Updated is the pending change that will be persisted, effects may adjust it.
+	Updated   Article
+	Actor     entity.Account
+	At        time.Time
+	PublishAt *time.Time
+	// Warnings are shown to the client without stopping the transition.
+	Warnings []string
+}
+
+// TransitionGuard decides whether a transition may happen.
+// Returning *TransitionRejectedError refuses it with a reason shown to the client.
+type TransitionGuard func(ctx context.Context, transition *StatusTransition) (err error)
+
+// TransitionHook is a side effect of a transition.
+type TransitionHook func(ctx context.Context, transition *StatusTransition) (err error)
+
+// StatusTransitionRule is a single edge of the article lifecycle.
+type StatusTransitionRule struct {
+	From   ArticleStatus
+	To     ArticleStatus
+	Guards []TransitionGuard
+	// Effects run after the guards and before the change is persisted.
+	Effects []TransitionHook
+	// After hooks run once the change is persisted, their errors are only logged.
+	After []TransitionHook
+}
+
+// TransitionRejectedError is the reason a transition was refused.
+type TransitionRejectedError struct {
+	From    ArticleStatus   `json:"from"`
+	To      ArticleStatus   `json:"to"`
+	Reason  string          `json:"reason"`
+	Allowed []ArticleStatus `json:"allowed,omitempty"`
+	Details interface{}     `json:"details,omitempty"`
+}
+
+func (e *TransitionRejectedError) Error() string {
+	return fmt.Sprintf("cannot move article from %s to %s: %s", e.From, e.To, e.Reason)
+}
+
+// ArticleStateMachine holds the allowed article status transitions.
+type ArticleStateMachine struct {
+	rules   map[ArticleStatus][]*StatusTransitionRule
+	guards  map[ArticleStatus][]TransitionGuard
+	effects map[ArticleStatus][]TransitionHook
+	after   map[ArticleStatus][]TransitionHook
+}
+
+// NewArticleStateMachine is a constructor, it comes with the default article lifecycle.
+func NewArticleStateMachine() *ArticleStateMachine {
+	m := &ArticleStateMachine{
+		rules:   make(map[ArticleStatus][]*StatusTransitionRule),
+		guards:  make(map[ArticleStatus][]TransitionGuard),
+		effects: make(map[ArticleStatus][]TransitionHook),
+		after:   make(map[ArticleStatus][]TransitionHook),
+	}
+
+	for _, rule := range defaultStatusTransitionRules() {
+		m.AddRule(rule)
+	}
+
+	return m
+}
+
+func defaultStatusTransitionRules() []StatusTransitionRule {
+	return []StatusTransitionRule{
+		{From: ArticleStatusDraft, To: ArticleStatusInReview},
+		{From: ArticleStatusDraft, To: ArticleStatusScheduled, Guards: []TransitionGuard{requireFuturePublishAt}, Effects: []TransitionHook{stampScheduledAt}},
+		{From: ArticleStatusDraft, To: ArticleStatusPublished, Effects: []TransitionHook{stampPublishedAt}},
+		{From: ArticleStatusInReview, To: ArticleStatusDraft},
+		{From: ArticleStatusInReview, To: ArticleStatusScheduled, Guards: []TransitionGuard{requireFuturePublishAt}, Effects: []TransitionHook{stampScheduledAt}},
+		{From: ArticleStatusInReview, To: ArticleStatusPublished, Effects: []TransitionHook{stampPublishedAt}},
+		{From: ArticleStatusScheduled, To: ArticleStatusDraft, Effects: []TransitionHook{clearPublishedAt}},
+		{From: ArticleStatusScheduled, To: ArticleStatusPublished, Effects: []TransitionHook{stampPublishedAt}},
+		{From: ArticleStatusPublished, To: ArticleStatusUnlisted},
+		{From: ArticleStatusPublished, To: ArticleStatusArchived},
+		{From: ArticleStatusUnlisted, To: ArticleStatusPublished},
+		{From: ArticleStatusUnlisted, To: ArticleStatusArchived},
+		// Unarchiving restores the article with its original publish date.
+		{From: ArticleStatusArchived, To: ArticleStatusPublished},
+	}
+}
+
+// AddRule registers a transition, replacing any existing rule of the same edge.
+func (m *ArticleStateMachine) AddRule(rule StatusTransitionRule) {
+	for i, existing := range m.rules[rule.From] {
+		if existing.To == rule.To {
+			m.rules[rule.From][i] = &rule
+			return
+		}
+	}
+
+	m.rules[rule.From] = append(m.rules[rule.From], &rule)
+}
+
+// Allowed returns the statuses an article in the given status may move to.
+func (m *ArticleStateMachine) Allowed(from ArticleStatus) (allowed []ArticleStatus) {
+	for _, rule := range m.rules[from] {
+		allowed = append(allowed, rule.To)
+	}
+
+	return
+}
+
+// Guard adds a guard to every transition into the given status.
+func (m *ArticleStateMachine) Guard(to ArticleStatus, guard TransitionGuard) {
+	m.guards[to] = append(m.guards[to], guard)
+}
+
+// OnEnter adds an effect to every transition into the given status.
+func (m *ArticleStateMachine) OnEnter(to ArticleStatus, hook TransitionHook) {
+	m.effects[to] = append(m.effects[to], hook)
+}
+
+// AfterEnter adds an after hook to every transition into the given status.
+func (m *ArticleStateMachine) AfterEnter(to ArticleStatus, hook TransitionHook) {
+	m.after[to] = append(m.after[to], hook)
+}
+
+func (m *ArticleStateMachine) guardsOf(rule *StatusTransitionRule) (guards []TransitionGuard) {
+	guards = append(guards, rule.Guards...)
+	return append(guards, m.guards[rule.To]...)
+}
+
+func (m *ArticleStateMachine) effectsOf(rule *StatusTransitionRule) (effects []TransitionHook) {
+	effects = append(effects, rule.Effects...)
+	return append(effects, m.effects[rule.To]...)
+}
+
+func (m *ArticleStateMachine) afterOf(rule *StatusTransitionRule) (after []TransitionHook) {
+	after = append(after, rule.After...)
+	return append(after, m.after[rule.To]...)
+}
+
+func (m *ArticleStateMachine) rule(from, to ArticleStatus) (rule *StatusTransitionRule, err error) {
+	for _, rule := range m.rules[from] {
+		if rule.To == to {
+			return rule, nil
+		}
+	}
+
+	allowed := m.Allowed(from)
+	reason := "transition is not allowed"
+	if len(allowed) > 0 {
+		names := make([]string, len(allowed))
+		for i, status := range allowed {
+			names[i] = string(status)
+		}
+		reason = fmt.Sprintf("transition is not allowed, allowed targets are %s", strings.Join(names, ", "))
+	}
+
+	return nil, &TransitionRejectedError{
+		From:    from,
+		To:      to,
+		Reason:  reason,
+		Allowed: allowed,
+	}
+}
+
+// Apply runs the guards and effects of the transition, leaving the change ready to be persisted.
+func (m *ArticleStateMachine) Apply(ctx context.Context, transition *StatusTransition) (err error) {
+	rule, err := m.rule(transition.From, transition.To)
+	if err != nil {
+		return
+	}
+
+	modifiedAt := transition.At
+	transition.Updated.Status = transition.To
+	transition.Updated.PublishedAt = transition.Article.PublishedAt
+	transition.Updated.LastModifiedAt = &modifiedAt
+
+	for _, guard := range m.guardsOf(rule) {
+		if err = guard(ctx, transition); err != nil {
+			return
+		}
+	}
+
+	for _, effect := range m.effectsOf(rule) {
+		if err = effect(ctx, transition); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Complete runs the after hooks of a persisted transition.
+func (m *ArticleStateMachine) Complete(ctx context.Context, transition *StatusTransition) {
+	rule, err := m.rule(transition.From, transition.To)
+	if err != nil {
+		return
+	}
+
+	for _, hook := range m.afterOf(rule) {
+		if err := hook(ctx, transition); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func requireFuturePublishAt(ctx context.Context, transition *StatusTransition) (err error) {
+	if transition.PublishAt == nil || !transition.PublishAt.After(transition.At) {
+		return &TransitionRejectedError{
+			From:   transition.From,
+			To:     transition.To,
+			Reason: "publishAt must be set to a time in the future",
+		}
+	}
+
+	return
+}
+
+// stampScheduledAt keeps the planned publish time in publishedAt until the article goes live.
+func stampScheduledAt(ctx context.Context, transition *StatusTransition) (err error) {
+	publishAt := transition.PublishAt.In(transition.At.Location())
+	transition.Updated.PublishedAt = &publishAt
+
+	return
+}
+
+func stampPublishedAt(ctx context.Context, transition *StatusTransition) (err error) {
+	publishedAt := transition.At
+	transition.Updated.PublishedAt = &publishedAt
+
+	return
+}
+
+func clearPublishedAt(ctx context.Context, transition *StatusTransition) (err error) {
+	transition.Updated.PublishedAt = nil
+
+	return
+}
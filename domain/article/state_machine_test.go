@@ -0,0 +1,138 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+)
+
+func TestStateMachineApply_InvalidTransition(t *testing.T) {
+	m := article.NewArticleStateMachine()
+
+	transition := &article.StatusTransition{
+		From: article.ArticleStatusDraft,
+		To:   article.ArticleStatusArchived,
+		At:   time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	rejection, ok := err.(*article.TransitionRejectedError)
+	if !ok {
+		t.Fatalf("error should be a rejection, got %v", err)
+	}
+	assert.ElementsMatch(t, []article.ArticleStatus{
+		article.ArticleStatusInReview,
+		article.ArticleStatusScheduled,
+		article.ArticleStatusPublished,
+	}, rejection.Allowed, "should name the allowed targets")
+}
+
+func TestStateMachineApply_PublishStampsPublishedAt(t *testing.T) {
+	m := article.NewArticleStateMachine()
+	now := time.Now().In(location)
+
+	transition := &article.StatusTransition{
+		From: article.ArticleStatusDraft,
+		To:   article.ArticleStatusPublished,
+		At:   now,
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	assert.NoError(t, err)
+	assert.Equal(t, article.ArticleStatusPublished, transition.Updated.Status)
+	assert.Equal(t, now, *transition.Updated.PublishedAt)
+	assert.Equal(t, now, *transition.Updated.LastModifiedAt, "a status change is a modification")
+}
+
+func TestStateMachineApply_UnarchiveKeepsPublishedAt(t *testing.T) {
+	m := article.NewArticleStateMachine()
+	publishedAt := time.Now().In(location).Add(-time.Hour * 48)
+
+	transition := &article.StatusTransition{
+		From: article.ArticleStatusArchived,
+		To:   article.ArticleStatusPublished,
+		Article: article.Article{
+			Status:      article.ArticleStatusArchived,
+			PublishedAt: &publishedAt,
+		},
+		At: time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	assert.NoError(t, err)
+	assert.Equal(t, publishedAt, *transition.Updated.PublishedAt)
+}
+
+func TestStateMachineApply_ScheduleRequiresFuturePublishAt(t *testing.T) {
+	m := article.NewArticleStateMachine()
+	past := time.Now().In(location).Add(-time.Hour)
+
+	transition := &article.StatusTransition{
+		From:      article.ArticleStatusDraft,
+		To:        article.ArticleStatusScheduled,
+		At:        time.Now().In(location),
+		PublishAt: &past,
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	_, ok := err.(*article.TransitionRejectedError)
+	assert.True(t, ok, "should be rejected")
+}
+
+func TestStateMachineGuard_Rejects(t *testing.T) {
+	m := article.NewArticleStateMachine()
+	m.Guard(article.ArticleStatusPublished, func(ctx context.Context, transition *article.StatusTransition) error {
+		return &article.TransitionRejectedError{From: transition.From, To: transition.To, Reason: "nope"}
+	})
+
+	transition := &article.StatusTransition{
+		From: article.ArticleStatusDraft,
+		To:   article.ArticleStatusPublished,
+		At:   time.Now().In(location),
+	}
+
+	err := m.Apply(context.TODO(), transition)
+
+	assert.Error(t, err)
+}
+
+func TestStateMachineComplete_RunsAfterHooks(t *testing.T) {
+	m := article.NewArticleStateMachine()
+	called := false
+	m.AfterEnter(article.ArticleStatusArchived, func(ctx context.Context, transition *article.StatusTransition) error {
+		called = true
+		return nil
+	})
+
+	transition := &article.StatusTransition{
+		From: article.ArticleStatusPublished,
+		To:   article.ArticleStatusArchived,
+		At:   time.Now().In(location),
+	}
+
+	assert.NoError(t, m.Apply(context.TODO(), transition))
+	m.Complete(context.TODO(), transition)
+
+	assert.True(t, called, "after hook should be called")
+}
+
+func TestArticleModifiedAt(t *testing.T) {
+	createdAt := time.Now().In(location).Add(-time.Hour * 72)
+	editedAt := createdAt.Add(time.Hour)
+	publishedAt := createdAt.Add(time.Hour * 2)
+	plannedAt := time.Now().In(location).Add(time.Hour * 24)
+
+	assert.Equal(t, createdAt, article.Article{CreatedAt: createdAt}.ModifiedAt())
+	assert.Equal(t, publishedAt, article.Article{CreatedAt: createdAt, LastModifiedAt: &editedAt, PublishedAt: &publishedAt}.ModifiedAt(),
+		"publishing after the last edit should count")
+	assert.Equal(t, editedAt, article.Article{CreatedAt: createdAt, LastModifiedAt: &editedAt, PublishedAt: &plannedAt}.ModifiedAt(),
+		"a planned publish time should not count yet")
+}
@@ -0,0 +1,125 @@
+package article
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type TranslationHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  TranslationUsecase
+}
+
+func NewTranslationHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase TranslationUsecase,
+) {
+	handler := &TranslationHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/article/{id:[0-9]+}/translations", bearerAuthMiddleware.VerifyBearer(handler.GetAll)).Methods(http.MethodGet)
+	//Put
+	router.HandleFunc("/v1/article/{id:[0-9]+}/translations/{lang}", bearerAuthMiddleware.VerifyBearer(handler.Upsert)).Methods(http.MethodPut)
+	//Delete
+	router.HandleFunc("/v1/article/{id:[0-9]+}/translations/{lang}", bearerAuthMiddleware.VerifyBearer(handler.Delete)).Methods(http.MethodDelete)
+}
+
+func (handler *TranslationHTTPHandler) Upsert(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UpsertTranslationRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.Language = path["lang"]
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Upsert(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *TranslationHTTPHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params DeleteTranslationRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID = convertedID
+	params.Language = path["lang"]
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Delete(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *TranslationHTTPHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetTranslationsRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ArticleID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetAll(ctx, params)
+	resp.JSON(w)
+}
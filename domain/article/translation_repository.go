@@ -0,0 +1,170 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type ArticleTranslationRepository interface {
+	Upsert(ctx context.Context, translation ArticleTranslation) (err error)
+	Delete(ctx context.Context, articleID int64, language string) (err error)
+	FindByArticle(ctx context.Context, articleID int64) (translations []ArticleTranslation, err error)
+	FindManyByArticles(ctx context.Context, articleIDs []int64, languages []string) (translations []ArticleTranslation, err error)
+}
+
+type articleTranslationRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+}
+
+func NewArticleTranslationRepository(db *sql.DB, tableName string) ArticleTranslationRepository {
+	return &articleTranslationRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (r *articleTranslationRepositoryImpl) Upsert(ctx context.Context, translation ArticleTranslation) (err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (articleId, language, title, subtitle, content, createdAt) VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE title = VALUES(title), subtitle = VALUES(subtitle), content = VALUES(content), lastModifiedAt = VALUES(createdAt)`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		translation.ArticleID,
+		translation.Language,
+		translation.Title,
+		translation.Subtitle,
+		translation.Content,
+		translation.CreatedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (r *articleTranslationRepositoryImpl) Delete(ctx context.Context, articleID int64, language string) (err error) {
+	command := fmt.Sprintf(`DELETE FROM %s WHERE articleId = ? AND language = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, articleID, language)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *articleTranslationRepositoryImpl) FindByArticle(ctx context.Context, articleID int64) (translations []ArticleTranslation, err error) {
+	query := fmt.Sprintf(`SELECT id, articleId, language, title, subtitle, content, createdAt, lastModifiedAt FROM %s WHERE articleId = ? ORDER BY language ASC`, r.tableName)
+
+	return r.query(ctx, query, articleID)
+}
+
+func (r *articleTranslationRepositoryImpl) FindManyByArticles(ctx context.Context, articleIDs []int64, languages []string) (translations []ArticleTranslation, err error) {
+	if len(articleIDs) == 0 || len(languages) == 0 {
+		return
+	}
+
+	args := make([]interface{}, 0, len(articleIDs)+len(languages))
+	for _, ID := range articleIDs {
+		args = append(args, ID)
+	}
+	for _, language := range languages {
+		args = append(args, language)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, articleId, language, title, subtitle, content, createdAt, lastModifiedAt FROM %s WHERE articleId IN (%s) AND language IN (%s)`,
+		r.tableName,
+		placeholders(len(articleIDs)),
+		placeholders(len(languages)),
+	)
+
+	return r.query(ctx, query, args...)
+}
+
+func (r *articleTranslationRepositoryImpl) query(ctx context.Context, query string, args ...interface{}) (translations []ArticleTranslation, err error) {
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		translation := ArticleTranslation{}
+		var lastModifiedAt sql.NullTime
+
+		err = rows.Scan(
+			&translation.ID,
+			&translation.ArticleID,
+			&translation.Language,
+			&translation.Title,
+			&translation.Subtitle,
+			&translation.Content,
+			&translation.CreatedAt,
+			&lastModifiedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		if lastModifiedAt.Valid {
+			translation.LastModifiedAt = &lastModifiedAt.Time
+		}
+
+		translations = append(translations, translation)
+	}
+
+	return
+}
+
+// placeholders returns `?, ?, ?` for n arguments of an IN clause.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,113 @@
+package article
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type TranslationUsecase interface {
+	Upsert(ctx context.Context, params UpsertTranslationRequest) (resp response.Response)
+	Delete(ctx context.Context, params DeleteTranslationRequest) (resp response.Response)
+	GetAll(ctx context.Context, params GetTranslationsRequest) (resp response.Response)
+}
+
+type translationUsecaseImpl struct {
+	location    *time.Location
+	repository  ArticleTranslationRepository
+	articleRepo ArticleRepository
+	accountRepo account.AccountRepository
+	moderation  *ModerationWorkflow
+}
+
+func NewTranslationUsecase(
+	location *time.Location,
+	repository ArticleTranslationRepository,
+	articleRepo ArticleRepository,
+	accountRepo account.AccountRepository,
+	moderation *ModerationWorkflow,
+) TranslationUsecase {
+	return &translationUsecaseImpl{
+		location:    location,
+		repository:  repository,
+		articleRepo: articleRepo,
+		accountRepo: accountRepo,
+		moderation:  moderation,
+	}
+}
+
+func (u *translationUsecaseImpl) Upsert(ctx context.Context, params UpsertTranslationRequest) (resp response.Response) {
+	article, resp := findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ArticleID)
+	if resp != nil {
+		return resp
+	}
+
+	language := normalizeLanguage(params.Language)
+
+	//The original text already covers its own language
+	if language == normalizeLanguage(article.Language) {
+		return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+	}
+
+	// A translation goes live with its article without passing the publish guards, so it is moderated here.
+	warnings, err := u.moderation.Check(ctx, article.ID, params.Title, params.Subtitle, params.Content)
+	if err != nil {
+		if rejection, ok := err.(*ContentRejectedError); ok {
+			return response.Error(response.StatusForbiddend, rejection, err)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	translation := ArticleTranslation{}
+	translation.ArticleID = article.ID
+	translation.Language = language
+	translation.Title = params.Title
+	translation.Subtitle = params.Subtitle
+	translation.Content = params.Content
+	translation.CreatedAt = time.Now().In(u.location)
+
+	err = u.repository.Upsert(ctx, translation)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	upsertTranslationResponse := UpsertTranslationResponse{}
+	upsertTranslationResponse.ArticleTranslation = translation
+	upsertTranslationResponse.Warnings = warnings
+
+	return response.Success(response.StatusOK, upsertTranslationResponse)
+}
+
+func (u *translationUsecaseImpl) Delete(ctx context.Context, params DeleteTranslationRequest) (resp response.Response) {
+	article, resp := findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ArticleID)
+	if resp != nil {
+		return resp
+	}
+
+	err := u.repository.Delete(ctx, article.ID, normalizeLanguage(params.Language))
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+func (u *translationUsecaseImpl) GetAll(ctx context.Context, params GetTranslationsRequest) (resp response.Response) {
+	article, resp := findOwnedArticle(ctx, u.accountRepo, u.articleRepo, params.ArticleID)
+	if resp != nil {
+		return resp
+	}
+
+	translations, err := u.repository.FindByArticle(ctx, article.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, translations)
+}
@@ -0,0 +1,105 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/moderation"
+	moderationMocks "github.com/sangianpatrick/devoria-article-service/moderation/mocks"
+)
+
+func TestTranslationUsecaseUpsert_Success(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 1}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Language: "id", Author: entity.Account{ID: 1}}, nil)
+
+	translationRepo := new(articleMocks.ArticleTranslationRepository)
+	translationRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(translation article.ArticleTranslation) bool {
+		return translation.ArticleID == 1 && translation.Language == "en-us"
+	})).Return(nil)
+
+	u := article.NewTranslationUsecase(location, translationRepo, articleRepo, accountRepo, article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Upsert(ctx, article.UpsertTranslationRequest{
+		ArticleID: 1,
+		Language:  "en-US",
+		Title:     "title",
+		Subtitle:  "subtitle",
+		Content:   "content",
+	})
+	assert.NoError(t, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	translationRepo.AssertExpectations(t)
+}
+
+func TestTranslationUsecaseUpsert_OriginalLanguage(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 1}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Language: "id", Author: entity.Account{ID: 1}}, nil)
+
+	translationRepo := new(articleMocks.ArticleTranslationRepository)
+
+	u := article.NewTranslationUsecase(location, translationRepo, articleRepo, accountRepo, article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Upsert(ctx, article.UpsertTranslationRequest{
+		ArticleID: 1,
+		Language:  "id",
+		Title:     "judul",
+		Subtitle:  "subjudul",
+		Content:   "isi",
+	})
+	assert.Equal(t, exception.ErrConflicted, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	translationRepo.AssertExpectations(t)
+}
+
+func TestTranslationUsecaseUpsert_BlockedByModeration(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 1}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Language: "id", Status: article.ArticleStatusPublished, Author: entity.Account{ID: 1}}, nil)
+
+	moderator := new(moderationMocks.ContentModerator)
+	moderator.On("Moderate", mock.Anything, mock.AnythingOfType("moderation.Content")).Return(moderation.Result{
+		Verdict: moderation.VerdictBlock,
+		Reasons: []moderation.Reason{{Check: "spam_link", Verdict: moderation.VerdictBlock, Rule: "blocked domain"}},
+	}, nil)
+
+	translationRepo := new(articleMocks.ArticleTranslationRepository)
+
+	u := article.NewTranslationUsecase(location, translationRepo, articleRepo, accountRepo, article.NewModerationWorkflow(moderator))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Upsert(ctx, article.UpsertTranslationRequest{
+		ArticleID: 1,
+		Language:  "en-US",
+		Title:     "title",
+		Subtitle:  "subtitle",
+		Content:   "content",
+	})
+
+	_, ok := resp.Err().(*article.ContentRejectedError)
+	assert.True(t, ok, "should be rejected")
+
+	moderator.AssertExpectations(t)
+	translationRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
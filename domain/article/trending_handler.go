@@ -0,0 +1,64 @@
+package article
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type TrendingHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  TrendingUsecase
+}
+
+func NewTrendingHTTPHandler(
+	router *mux.Router,
+	basicAuthMiddleware middleware.RouteMiddleware,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase TrendingUsecase,
+) {
+	handler := &TrendingHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/article/trending", bearerAuthMiddleware.VerifyBearerOrFallback(basicAuthMiddleware, handler.GetTrending)).Methods(http.MethodGet)
+}
+
+func (handler *TrendingHTTPHandler) GetTrending(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetTrendingArticlesRequest
+	var ctx = r.Context()
+	var err error
+	query := r.URL.Query()
+
+	params.Window = TrendingWindow(query.Get("window"))
+	if params.Window == "" {
+		params.Window = TrendingWindowDay
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		params.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+			resp.JSON(w)
+			return
+		}
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetTrending(ctx, params)
+	resp.JSON(w)
+}
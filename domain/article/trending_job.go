@@ -0,0 +1,103 @@
+package article
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"time"
+)
+
+// trendingWindowSpec is how far a window looks back and how fast interactions lose weight in it.
+type trendingWindowSpec struct {
+	period   time.Duration
+	halfLife time.Duration
+}
+
+var trendingWindows = map[TrendingWindow]trendingWindowSpec{
+	TrendingWindowDay:  {period: time.Hour * 24, halfLife: time.Hour * 6},
+	TrendingWindowWeek: {period: time.Hour * 24 * 7, halfLife: time.Hour * 48},
+}
+
+// A comment takes more effort than a reaction, and a reaction more than a view.
+var engagementWeights = map[EngagementKind]float64{
+	EngagementKindView:     1,
+	EngagementKindReaction: 3,
+	EngagementKindComment:  5,
+}
+
+// TrendingJob precomputes the trending rankings so reading them is a single sorted set lookup.
+type TrendingJob struct {
+	interval   time.Duration
+	location   *time.Location
+	repository EngagementRepository
+	store      TrendingStore
+}
+
+// NewTrendingJob is a constructor.
+func NewTrendingJob(interval time.Duration, location *time.Location, repository EngagementRepository, store TrendingStore) *TrendingJob {
+	return &TrendingJob{
+		interval:   interval,
+		location:   location,
+		repository: repository,
+		store:      store,
+	}
+}
+
+// Run refreshes the rankings right away and then on every tick until the context is done.
+func (j *TrendingJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Refresh(ctx); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh recomputes the ranking of every window.
+func (j *TrendingJob) Refresh(ctx context.Context) (err error) {
+	now := time.Now().In(j.location)
+
+	for window, spec := range trendingWindows {
+		buckets, err := j.repository.AggregatePublishedSince(ctx, now.Add(-spec.period), now)
+		if err != nil {
+			return err
+		}
+
+		err = j.store.Replace(ctx, window, decayedScores(buckets, spec.halfLife))
+		if err != nil {
+			return err
+		}
+	}
+
+	return
+}
+
+// decayedScores halves the weight of an interaction every half-life.
+func decayedScores(buckets []EngagementBucket, halfLife time.Duration) (scores []TrendingScore) {
+	byArticle := make(map[int64]float64)
+	halfLifeHours := halfLife.Hours()
+
+	for _, bucket := range buckets {
+		decay := math.Pow(0.5, float64(bucket.AgeHours)/halfLifeHours)
+		byArticle[bucket.ArticleID] += engagementWeights[bucket.Kind] * float64(bucket.Count) * decay
+	}
+
+	for ID, score := range byArticle {
+		scores = append(scores, TrendingScore{ArticleID: ID, Score: score})
+	}
+
+	sort.Slice(scores, func(i, k int) bool {
+		return scores[i].Score > scores[k].Score
+	})
+
+	return
+}
@@ -0,0 +1,56 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+
+	rv8 "github.com/go-redis/redis/v8"
+	redismock "github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+)
+
+func TestTrendingJobRefresh_DecaysOlderEngagement(t *testing.T) {
+	engagementRepo := new(articleMocks.EngagementRepository)
+	engagementRepo.On("AggregatePublishedSince", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]article.EngagementBucket{
+		{ArticleID: 1, Kind: article.EngagementKindView, AgeHours: 20, Count: 10},
+		{ArticleID: 2, Kind: article.EngagementKindView, AgeHours: 0, Count: 4},
+		{ArticleID: 2, Kind: article.EngagementKindComment, AgeHours: 1, Count: 1},
+	}, nil)
+
+	store := new(articleMocks.TrendingStore)
+	store.On("Replace", mock.Anything, mock.AnythingOfType("article.TrendingWindow"), mock.MatchedBy(func(scores []article.TrendingScore) bool {
+		return len(scores) == 2 && scores[0].ArticleID == 2
+	})).Return(nil)
+
+	job := article.NewTrendingJob(0, location, engagementRepo, store)
+
+	err := job.Refresh(context.TODO())
+	assert.NoError(t, err)
+
+	store.AssertNumberOfCalls(t, "Replace", 2)
+	engagementRepo.AssertExpectations(t)
+	store.AssertExpectations(t)
+}
+
+func TestRedisTrendingStoreTop(t *testing.T) {
+	rdb, redisMock := redismock.NewClientMock()
+	redisMock.ExpectZRevRangeWithScores("article:trending:24h", 0, 1).SetVal([]rv8.Z{
+		{Score: 8.5, Member: "2"},
+		{Score: 3, Member: "1"},
+	})
+
+	store := article.NewRedisTrendingStore(rdb, "article:trending")
+
+	scores, err := store.Top(context.TODO(), article.TrendingWindowDay, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []article.TrendingScore{{ArticleID: 2, Score: 8.5}, {ArticleID: 1, Score: 3}}, scores)
+
+	if err := redisMock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
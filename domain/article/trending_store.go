@@ -0,0 +1,87 @@
+package article
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	rv8 "github.com/go-redis/redis/v8"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type TrendingStore interface {
+	Replace(ctx context.Context, window TrendingWindow, scores []TrendingScore) (err error)
+	Top(ctx context.Context, window TrendingWindow, limit int) (scores []TrendingScore, err error)
+}
+
+type redisTrendingStore struct {
+	c         rv8.UniversalClient
+	keyPrefix string
+}
+
+func NewRedisTrendingStore(rdb rv8.UniversalClient, keyPrefix string) TrendingStore {
+	return &redisTrendingStore{
+		c:         rdb,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (s *redisTrendingStore) key(window TrendingWindow) string {
+	return fmt.Sprintf("%s:%s", s.keyPrefix, window)
+}
+
+// Replace builds the new ranking aside and renames it over the old one, readers never see a half written set.
+func (s *redisTrendingStore) Replace(ctx context.Context, window TrendingWindow, scores []TrendingScore) (err error) {
+	key := s.key(window)
+
+	if len(scores) == 0 {
+		err = s.c.Del(ctx, key).Err()
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+		}
+		return
+	}
+
+	members := make([]*rv8.Z, len(scores))
+	for i, score := range scores {
+		members[i] = &rv8.Z{Score: score.Score, Member: score.ArticleID}
+	}
+
+	building := key + ":building"
+	pipe := s.c.TxPipeline()
+	pipe.Del(ctx, building)
+	pipe.ZAdd(ctx, building, members...)
+	pipe.Rename(ctx, building, key)
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (s *redisTrendingStore) Top(ctx context.Context, window TrendingWindow, limit int) (scores []TrendingScore, err error) {
+	members, err := s.c.ZRevRangeWithScores(ctx, s.key(window), 0, int64(limit-1)).Result()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	for _, member := range members {
+		ID, err := strconv.ParseInt(fmt.Sprint(member.Member), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		scores = append(scores, TrendingScore{ArticleID: ID, Score: member.Score})
+	}
+
+	return
+}
@@ -0,0 +1,78 @@
+package article
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// TrendingArticlesDefaultLimit is the number of trending articles returned when none is requested.
+const TrendingArticlesDefaultLimit = 10
+
+type TrendingUsecase interface {
+	GetTrending(ctx context.Context, params GetTrendingArticlesRequest) (resp response.Response)
+}
+
+type trendingUsecaseImpl struct {
+	store       TrendingStore
+	articleRepo ArticleRepository
+}
+
+func NewTrendingUsecase(store TrendingStore, articleRepo ArticleRepository) TrendingUsecase {
+	return &trendingUsecaseImpl{
+		store:       store,
+		articleRepo: articleRepo,
+	}
+}
+
+func (u *trendingUsecaseImpl) GetTrending(ctx context.Context, params GetTrendingArticlesRequest) (resp response.Response) {
+	limit := params.Limit
+	if limit < 1 {
+		limit = TrendingArticlesDefaultLimit
+	}
+
+	scores, err := u.store.Top(ctx, params.Window, limit)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	arr := []TrendingArticleResponse{}
+
+	for _, score := range scores {
+		element, err := u.articleRepo.FindByID(ctx, score.ArticleID)
+		if err != nil {
+			if err == exception.ErrNotFound {
+				continue
+			}
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+
+		//The ranking is refreshed periodically, the article may have gone offline since
+		if element.Status != ArticleStatusPublished {
+			continue
+		}
+
+		restrict(ctx, &element)
+
+		m := TrendingArticleResponse{}
+		m.ID = element.ID
+		m.Title = element.Title
+		m.Subtitle = element.Subtitle
+		m.Content = element.Content
+		m.Language = element.Language
+		m.AccessLevel = element.AccessLevel
+		m.Locked = element.Locked
+		m.Status = element.Status
+		m.CreatedAt = element.CreatedAt
+		m.PublishedAt = element.PublishedAt
+		m.LastModifiedAt = element.LastModifiedAt
+		m.AuthorID = element.Author.ID
+		m.Slug = element.Slug
+		m.Score = score.Score
+
+		arr = append(arr, m)
+	}
+
+	return response.Success(response.StatusOK, arr)
+}
@@ -3,6 +3,7 @@ package article
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/sangianpatrick/devoria-article-service/crypto"
@@ -17,20 +18,27 @@ import (
 type ArticleUsecase interface {
 	Create(ctx context.Context, params CreateArticleRequest) (resp response.Response)
 	Edit(ctx context.Context, params EditArticleRequest) (resp response.Response)
-	GetAllPublic(ctx context.Context) (resp response.Response)
-	GetAllPrivate(ctx context.Context) (resp response.Response)
+	GetAllPublic(ctx context.Context, params ListArticleRequest) (resp response.Response)
+	GetAllPrivate(ctx context.Context, params ListArticleRequest) (resp response.Response)
 	EditStatus(ctx context.Context, params EditStatusArticleRequest) (resp response.Response)
 	GetOne(ctx context.Context, params GetOneArticleRequest) (resp response.Response)
+	GetSummary(ctx context.Context, params ArticleSummaryRequest) (resp response.Response)
 }
 
 type articleUsecaseImpl struct {
-	globalIV     string
-	session      session.Session
-	jsonWebToken jwt.JSONWebToken
-	crypto       crypto.Crypto
-	location     *time.Location
-	repository   ArticleRepository
-	accountRepo  account.AccountRepository
+	globalIV          string
+	session           session.Session
+	jsonWebToken      jwt.JSONWebToken
+	crypto            crypto.Crypto
+	location          *time.Location
+	repository        ArticleRepository
+	accountRepo       account.AccountRepository
+	stateMachine      *ArticleStateMachine
+	translationRepo   ArticleTranslationRepository
+	duplicateDetector *DuplicateDetector
+	viewCounter       *ViewCounter
+	linkGraph         *LinkGraph
+	moderation        *ModerationWorkflow
 }
 
 func NewArticleUsecase(
@@ -41,18 +49,58 @@ func NewArticleUsecase(
 	location *time.Location,
 	repository ArticleRepository,
 	accountRepo account.AccountRepository,
+	stateMachine *ArticleStateMachine,
+	translationRepo ArticleTranslationRepository,
+	duplicateDetector *DuplicateDetector,
+	viewCounter *ViewCounter,
+	linkGraph *LinkGraph,
+	moderation *ModerationWorkflow,
 ) ArticleUsecase {
 	return &articleUsecaseImpl{
-		globalIV:     globalIV,
-		session:      session,
-		jsonWebToken: jsonWebToken,
-		crypto:       crypto,
-		location:     location,
-		repository:   repository,
-		accountRepo:  accountRepo,
+		globalIV:          globalIV,
+		session:           session,
+		jsonWebToken:      jsonWebToken,
+		crypto:            crypto,
+		location:          location,
+		repository:        repository,
+		accountRepo:       accountRepo,
+		stateMachine:      stateMachine,
+		translationRepo:   translationRepo,
+		duplicateDetector: duplicateDetector,
+		viewCounter:       viewCounter,
+		linkGraph:         linkGraph,
+		moderation:        moderation,
 	}
 }
 
+// localizeMany swaps in the preferred translations of a listing with a single query.
+func (u *articleUsecaseImpl) localizeMany(ctx context.Context, articles []Article, languages []string) (err error) {
+	if len(languages) == 0 || len(articles) == 0 {
+		return
+	}
+
+	IDs := make([]int64, len(articles))
+	for i, article := range articles {
+		IDs[i] = article.ID
+	}
+
+	translations, err := u.translationRepo.FindManyByArticles(ctx, IDs, expandLanguages(languages))
+	if err != nil {
+		return
+	}
+
+	byArticle := make(map[int64][]ArticleTranslation)
+	for _, translation := range translations {
+		byArticle[translation.ArticleID] = append(byArticle[translation.ArticleID], translation)
+	}
+
+	for i := range articles {
+		localize(&articles[i], languages, byArticle[articles[i].ID])
+	}
+
+	return
+}
+
 func (u *articleUsecaseImpl) Create(ctx context.Context, params CreateArticleRequest) (resp response.Response) {
 	// Get detail author/account
 	email := ctx.Value(entity.EmailCtx).(string)
@@ -66,8 +114,17 @@ func (u *articleUsecaseImpl) Create(ctx context.Context, params CreateArticleReq
 
 	newArticle := Article{}
 	newArticle.Title = params.Title
+	newArticle.Slug = slugify(params.Title)
 	newArticle.Subtitle = params.Subtitle
 	newArticle.Content = params.Content
+	newArticle.Language = ArticleDefaultLanguage
+	if params.Language != "" {
+		newArticle.Language = normalizeLanguage(params.Language)
+	}
+	newArticle.AccessLevel = ArticleAccessLevelPublic
+	if params.AccessLevel != "" {
+		newArticle.AccessLevel = params.AccessLevel
+	}
 	newArticle.Status = ArticleStatusDraft
 	newArticle.CreatedAt = time.Now().In(u.location)
 	newArticle.Author = account
@@ -81,7 +138,19 @@ func (u *articleUsecaseImpl) Create(ctx context.Context, params CreateArticleReq
 
 	newArticle.ID = ID
 
-	return response.Success(response.StatusCreated, newArticle)
+	// The article is saved already, failing now would make a retrying client create it twice.
+	duplicates, err := u.duplicateDetector.Check(ctx, newArticle)
+	if err != nil {
+		log.Println(err)
+		duplicates = nil
+	}
+
+	createdArticleResponse := CreatedArticleResponse{}
+	createdArticleResponse.Article = newArticle
+	createdArticleResponse.Duplicates = duplicates
+	createdArticleResponse.Warnings = u.linkGraph.Index(ctx, newArticle)
+
+	return response.Success(response.StatusCreated, createdArticleResponse)
 }
 
 func (u *articleUsecaseImpl) Edit(ctx context.Context, params EditArticleRequest) (resp response.Response) {
@@ -95,12 +164,42 @@ func (u *articleUsecaseImpl) Edit(ctx context.Context, params EditArticleRequest
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
+	article, err := u.repository.FindByID(ctx, params.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if article.Author.ID != account.ID {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+	}
+
+	// A scheduled article goes live as it was approved, it has to be moved back to draft to be changed.
+	if article.Status == ArticleStatusScheduled {
+		return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
+	}
+
+	// Moderation guards going live, the text of a live article is checked on every edit.
+	var warnings []string
+	if !article.Status.IsUnreleased() {
+		warnings, err = u.moderation.Check(ctx, article.ID, params.Title, params.Subtitle, params.Content)
+		if err != nil {
+			if rejection, ok := err.(*ContentRejectedError); ok {
+				return response.Error(response.StatusForbiddend, rejection, err)
+			}
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+	}
+
 	lastModifiedAt := time.Now().In(u.location)
 
 	newArticle := Article{}
 	newArticle.Title = params.Title
 	newArticle.Subtitle = params.Subtitle
 	newArticle.Content = params.Content
+	newArticle.AccessLevel = params.AccessLevel
 	newArticle.LastModifiedAt = &lastModifiedAt
 	err = u.repository.Update(ctx, params.ID, account.ID, newArticle)
 	if err != nil {
@@ -110,17 +209,31 @@ func (u *articleUsecaseImpl) Edit(ctx context.Context, params EditArticleRequest
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	return response.Success(response.StatusOK, params)
+	editArticleResponse := EditArticleResponse{}
+	editArticleResponse.EditArticleRequest = params
+	editArticleResponse.Warnings = append(warnings, u.linkGraph.Index(ctx, Article{ID: params.ID, Content: params.Content})...)
+
+	return response.Success(response.StatusOK, editArticleResponse)
 }
 
-func (u *articleUsecaseImpl) GetAllPublic(ctx context.Context) (resp response.Response) {
-	articles, err := u.repository.FindMany(ctx)
+func (u *articleUsecaseImpl) GetAllPublic(ctx context.Context, params ListArticleRequest) (resp response.Response) {
+	//Only live articles are listed, unlisted ones are reachable by their link alone
+	articles, err := u.repository.FindManyByStatus(ctx, ArticleStatusPublished)
 	if err != nil {
 		if err == exception.ErrNotFound {
 			return response.Error(response.StatusNotFound, nil, exception.ErrBadRequest)
 		}
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
+
+	err = u.localizeMany(ctx, articles, params.Languages)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+	for i := range articles {
+		restrict(ctx, &articles[i])
+	}
+
 	var arr []GetArticleResponse
 
 	for _, element := range articles {
@@ -128,18 +241,29 @@ func (u *articleUsecaseImpl) GetAllPublic(ctx context.Context) (resp response.Re
 		m.ID = element.ID
 		m.Title = element.Title
 		m.Content = element.Content
+		m.Language = element.Language
+		m.AccessLevel = element.AccessLevel
+		m.Locked = element.Locked
 		m.Status = element.Status
 		m.CreatedAt = element.CreatedAt
 		m.LastModifiedAt = element.LastModifiedAt
 		m.AuthorID = element.Author.ID
+		m.Slug = element.Slug
 
 		arr = append(arr, m)
 	}
 
-	return response.Success(response.StatusOK, arr)
+	if expands(params.Expand, ArticleExpandAuthor) {
+		err = expandAuthors(ctx, u.accountRepo, arr)
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+	}
+
+	return response.WithLastModified(response.Success(response.StatusOK, arr), latestModifiedAt(articles))
 }
 
-func (u *articleUsecaseImpl) GetAllPrivate(ctx context.Context) (resp response.Response) {
+func (u *articleUsecaseImpl) GetAllPrivate(ctx context.Context, params ListArticleRequest) (resp response.Response) {
 	// Get detail author/account
 	email := ctx.Value(entity.EmailCtx).(string)
 	account, err := u.accountRepo.FindByEmail(ctx, email)
@@ -157,6 +281,11 @@ func (u *articleUsecaseImpl) GetAllPrivate(ctx context.Context) (resp response.R
 		}
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
+
+	err = u.localizeMany(ctx, articles, params.Languages)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
 	var arr []GetArticleResponse
 
 	for _, element := range articles {
@@ -166,16 +295,27 @@ func (u *articleUsecaseImpl) GetAllPrivate(ctx context.Context) (resp response.R
 		m.Title = element.Title
 		m.Subtitle = element.Subtitle
 		m.Content = element.Content
+		m.Language = element.Language
+		m.AccessLevel = element.AccessLevel
+		m.Locked = element.Locked
 		m.Status = element.Status
 		m.CreatedAt = element.CreatedAt
 		m.PublishedAt = element.PublishedAt
 		m.LastModifiedAt = element.LastModifiedAt
 		m.AuthorID = element.Author.ID
+		m.Slug = element.Slug
 
 		arr = append(arr, m)
 	}
 
-	return response.Success(response.StatusOK, arr)
+	if expands(params.Expand, ArticleExpandAuthor) {
+		err = expandAuthors(ctx, u.accountRepo, arr)
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+	}
+
+	return response.WithLastModified(response.Success(response.StatusOK, arr), latestModifiedAt(articles))
 }
 
 func (u *articleUsecaseImpl) EditStatus(ctx context.Context, params EditStatusArticleRequest) (resp response.Response) {
@@ -189,11 +329,6 @@ func (u *articleUsecaseImpl) EditStatus(ctx context.Context, params EditStatusAr
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	//Only allow update to published or archived
-	if params.Status != ArticleStatusPublished && params.Status != ArticleStatusArchived {
-		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
-	}
-
 	article, err := u.repository.FindByID(ctx, params.ID)
 	if err != nil {
 		if err == exception.ErrNotFound {
@@ -202,41 +337,45 @@ func (u *articleUsecaseImpl) EditStatus(ctx context.Context, params EditStatusAr
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	//Check if article status is archived on database
-	if article.Status == ArticleStatusArchived {
-		return response.Error(response.StatusForbiddend, nil, exception.ErrInternalServer)
+	if article.Author.ID != account.ID {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
 	}
 
-	newArticle := Article{}
-
-	newArticle.Status = params.Status
-	newArticle.PublishedAt = article.PublishedAt
-
-	//Generate publishedAt if status = publised
-	if params.Status == ArticleStatusPublished {
-		//Check if article status is already published
-		if article.Status == ArticleStatusPublished {
-			return response.Error(response.StatusForbiddend, nil, exception.ErrInternalServer)
-		}
-		publishedAt := time.Now().In(u.location)
-		newArticle.PublishedAt = &publishedAt
+	transition := &StatusTransition{
+		From:      article.Status,
+		To:        params.Status,
+		Article:   article,
+		Actor:     account,
+		At:        time.Now().In(u.location),
+		PublishAt: params.PublishAt,
+	}
 
-	} else if params.Status == ArticleStatusArchived {
-		//Check if article status is stil draft
-		if article.Status == ArticleStatusDraft {
-			return response.Error(response.StatusForbiddend, nil, exception.ErrInternalServer)
+	err = u.stateMachine.Apply(ctx, transition)
+	if err != nil {
+		if rejection, ok := err.(*TransitionRejectedError); ok {
+			return response.Error(response.StatusForbiddend, rejection, err)
 		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	err = u.repository.UpdateStatus(ctx, params.ID, account.ID, newArticle)
+	err = u.repository.UpdateStatus(ctx, params.ID, account.ID, transition.From, transition.Updated)
 	if err != nil {
-		if err == exception.ErrNotFound {
-			return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+		if err == exception.ErrConflicted {
+			return response.Error(response.StatusConflicted, nil, exception.ErrConflicted)
 		}
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	return response.Success(response.StatusOK, params)
+	u.stateMachine.Complete(ctx, transition)
+
+	editStatusArticleResponse := EditStatusArticleResponse{}
+	editStatusArticleResponse.ID = article.ID
+	editStatusArticleResponse.Status = transition.Updated.Status
+	editStatusArticleResponse.PreviousStatus = transition.From
+	editStatusArticleResponse.PublishedAt = transition.Updated.PublishedAt
+	editStatusArticleResponse.Warnings = transition.Warnings
+
+	return response.Success(response.StatusOK, editStatusArticleResponse)
 }
 
 func (u *articleUsecaseImpl) GetOne(ctx context.Context, params GetOneArticleRequest) (resp response.Response) {
@@ -248,5 +387,107 @@ func (u *articleUsecaseImpl) GetOne(ctx context.Context, params GetOneArticleReq
 		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
 	}
 
-	return response.Success(response.StatusOK, article)
+	//Anonymous readers only get to see what is live
+	if !isAuthenticated(ctx) && article.Status != ArticleStatusPublished && article.Status != ArticleStatusUnlisted {
+		return response.Error(response.StatusNotFound, nil, exception.ErrBadRequest)
+	}
+
+	//Unreleased articles are for their author only, others read them through the review queue or a preview link
+	if article.Status.IsUnreleased() {
+		account, err := u.accountRepo.FindByEmail(ctx, ctx.Value(entity.EmailCtx).(string))
+		if err != nil && err != exception.ErrNotFound {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+		if err != nil || account.ID != article.Author.ID {
+			return response.Error(response.StatusNotFound, nil, exception.ErrBadRequest)
+		}
+	}
+
+	if len(params.Languages) > 0 {
+		translations, err := u.translationRepo.FindByArticle(ctx, article.ID)
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+		localize(&article, params.Languages, translations)
+	}
+
+	restrict(ctx, &article)
+
+	if article.Status == ArticleStatusPublished {
+		u.viewCounter.Count(newArticleView(ctx, article, time.Now().In(u.location)))
+	}
+
+	if expands(params.Expand, ArticleExpandAuthor) {
+		profiles, err := loadAuthors(ctx, u.accountRepo, []int64{article.Author.ID})
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+
+		expanded := ExpandedArticleResponse{Article: article}
+		if profile, ok := profiles[article.Author.ID]; ok {
+			expanded.Author = &profile
+		}
+
+		return response.WithLastModified(response.Success(response.StatusOK, expanded), article.ModifiedAt())
+	}
+
+	return response.WithLastModified(response.Success(response.StatusOK, article), article.ModifiedAt())
+}
+
+// Defaults of the author dashboard.
+const (
+	summaryDefaultWeeks    = 12
+	summaryMostEditedLimit = 5
+)
+
+func (u *articleUsecaseImpl) GetSummary(ctx context.Context, params ArticleSummaryRequest) (resp response.Response) {
+	// Get detail author/account
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	weeks := params.Weeks
+	if weeks == 0 {
+		weeks = summaryDefaultWeeks
+	}
+	since := startOfWeek(time.Now().In(u.location)).AddDate(0, 0, -7*(weeks-1))
+
+	summary, err := u.repository.SummarizeByAuthor(ctx, account.ID, since, summaryMostEditedLimit)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	summary.WeeklyPublished = fillWeeks(since, weeks, summary.WeeklyPublished)
+
+	return response.Success(response.StatusOK, summary)
+}
+
+// startOfWeek is the Monday midnight of the week the time falls in.
+func startOfWeek(t time.Time) time.Time {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return midnight.AddDate(0, 0, -((int(midnight.Weekday()) + 6) % 7))
+}
+
+// fillWeeks turns the weeks the author published in into a series without gaps.
+func fillWeeks(since time.Time, weeks int, published []WeeklyPublishCount) (series []WeeklyPublishCount) {
+	counts := make(map[string]int)
+	for _, week := range published {
+		counts[week.WeekStart.Format("2006-01-02")] = week.Published
+	}
+
+	series = make([]WeeklyPublishCount, weeks)
+	for i := range series {
+		weekStart := since.AddDate(0, 0, 7*i)
+		series[i] = WeeklyPublishCount{
+			WeekStart: weekStart,
+			Published: counts[weekStart.Format("2006-01-02")],
+		}
+	}
+
+	return
 }
@@ -2,6 +2,10 @@ package article_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,12 +17,17 @@ import (
 	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
 	"github.com/sangianpatrick/devoria-article-service/domain/article"
 	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
 	jsonWebTokenMocks "github.com/sangianpatrick/devoria-article-service/jwt/mocks"
+	"github.com/sangianpatrick/devoria-article-service/moderation"
+	moderationMocks "github.com/sangianpatrick/devoria-article-service/moderation/mocks"
+	"github.com/sangianpatrick/devoria-article-service/response"
 	sessionMocks "github.com/sangianpatrick/devoria-article-service/session/mocks"
 )
 
 var (
 	location *time.Location
+	baseURL  string = "http://localhost:9001"
 )
 
 func TestMain(m *testing.M) {
@@ -34,10 +43,12 @@ func TestUsecaseCreate_Success(t *testing.T) {
 	accountRepo := new(accountMocks.AccountRepository)
 	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{}, nil)
 	articleRepo := new(articleMocks.ArticleRepository)
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("ReplaceLinks", mock.Anything, int64(1), []int64{}).Return(nil)
 
 	articleRepo.On("Save", mock.Anything, mock.AnythingOfType("article.Article")).Return(int64(1), nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, linkRepo), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
 	params := article.CreateArticleRequest{
@@ -53,9 +64,36 @@ func TestUsecaseCreate_Success(t *testing.T) {
 	crypto.AssertExpectations(t)
 	accountRepo.AssertExpectations(t)
 	articleRepo.AssertExpectations(t)
+	linkRepo.AssertExpectations(t)
 
 }
 
+func TestUsecaseCreate_DuplicateCheckFailureStillCreates(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{ID: 2}, nil)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("Save", mock.Anything, mock.AnythingOfType("article.Article")).Return(int64(1), nil)
+	duplicateRepo := new(articleMocks.DuplicateRepository)
+	duplicateRepo.On("FindSimilarFingerprints", mock.Anything, mock.Anything).Return(nil, exception.ErrInternalServer)
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("ReplaceLinks", mock.Anything, int64(1), []int64{}).Return(nil)
+
+	u := article.NewArticleUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, duplicateRepo), viewCounter(), article.NewLinkGraph(baseURL, linkRepo), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	params := article.CreateArticleRequest{
+		Title:    "test",
+		Subtitle: "test",
+		Content:  "Golang is an open source programming language that makes it simple to build secure, scalable systems and services.",
+	}
+	resp := u.Create(ctx, params)
+
+	assert.NoError(t, resp.Err())
+	assert.Empty(t, response.Data(resp).(article.CreatedArticleResponse).Duplicates)
+	articleRepo.AssertNumberOfCalls(t, "Save", 1)
+	duplicateRepo.AssertExpectations(t)
+}
+
 func TestUsecaseEdit_Success(t *testing.T) {
 	sess := new(sessionMocks.Session)
 	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
@@ -64,13 +102,16 @@ func TestUsecaseEdit_Success(t *testing.T) {
 	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(entity.Account{}, nil)
 
 	articleRepo := new(articleMocks.ArticleRepository)
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("ReplaceLinks", mock.Anything, int64(1), []int64{}).Return(nil)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft}, nil)
 	articleRepo.On("Update",
 		mock.Anything,
 		mock.AnythingOfType("int64"),
 		mock.AnythingOfType("int64"),
 		mock.AnythingOfType("article.Article")).Return(nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, linkRepo), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
 	params := article.EditArticleRequest{
@@ -87,7 +128,101 @@ func TestUsecaseEdit_Success(t *testing.T) {
 	crypto.AssertExpectations(t)
 	accountRepo.AssertExpectations(t)
 	articleRepo.AssertExpectations(t)
+	linkRepo.AssertExpectations(t)
+
+}
+
+// viewCounter is never run in these tests, the views it queues are not counted.
+func viewCounter() *article.ViewCounter {
+	return article.NewViewCounter(article.ArticleViewWindow, 16, new(articleMocks.ViewThrottle), new(articleMocks.EngagementRepository), new(accountMocks.AccountRepository))
+}
+
+func editArticleUsecase(articleRepo *articleMocks.ArticleRepository, linkRepo *articleMocks.ArticleLinkRepository, moderator moderation.ContentModerator) article.ArticleUsecase {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "email@gmail.co").Return(entity.Account{ID: 1}, nil)
+
+	return article.NewArticleUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, linkRepo), article.NewModerationWorkflow(moderator))
+}
+
+func TestUsecaseEdit_PublishedBlockedByModeration(t *testing.T) {
+	moderator := new(moderationMocks.ContentModerator)
+	moderator.On("Moderate", mock.Anything, moderation.Content{Kind: article.ModerationContentKind, Text: []string{"test", "test", "buy cheap pills"}}).Return(moderation.Result{
+		Verdict: moderation.VerdictBlock,
+		Reasons: []moderation.Reason{{Check: "wordlist", Verdict: moderation.VerdictBlock, Rule: "pills"}},
+	}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusPublished, Author: entity.Account{ID: 1}}, nil)
+
+	u := editArticleUsecase(articleRepo, new(articleMocks.ArticleLinkRepository), moderator)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Edit(ctx, article.EditArticleRequest{ID: 1, Title: "test", Subtitle: "test", Content: "buy cheap pills"})
+
+	_, ok := resp.Err().(*article.ContentRejectedError)
+	assert.True(t, ok, "should be rejected")
+
+	moderator.AssertExpectations(t)
+	articleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecaseEdit_PublishedFlaggedByModeration(t *testing.T) {
+	moderator := new(moderationMocks.ContentModerator)
+	moderator.On("Moderate", mock.Anything, mock.AnythingOfType("moderation.Content")).Return(moderation.Result{
+		Verdict: moderation.VerdictFlag,
+		Reasons: []moderation.Reason{{Check: "wordlist", Verdict: moderation.VerdictFlag, Rule: "cheap"}},
+	}, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusPublished, Author: entity.Account{ID: 1}}, nil)
+	articleRepo.On("Update", mock.Anything, int64(1), int64(1), mock.AnythingOfType("article.Article")).Return(nil)
+
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("ReplaceLinks", mock.Anything, int64(1), []int64{}).Return(nil)
+
+	u := editArticleUsecase(articleRepo, linkRepo, moderator)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Edit(ctx, article.EditArticleRequest{ID: 1, Title: "test", Subtitle: "test", Content: "cheap"})
+	assert.NoError(t, resp.Err())
+
+	edited := response.Data(resp).(article.EditArticleResponse)
+	assert.Equal(t, []string{"flagged by wordlist: cheap"}, edited.Warnings)
+
+	moderator.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+}
+
+func TestUsecaseEdit_ScheduledIsRefused(t *testing.T) {
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusScheduled, Author: entity.Account{ID: 1}}, nil)
+
+	u := editArticleUsecase(articleRepo, new(articleMocks.ArticleLinkRepository), new(moderationMocks.ContentModerator))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Edit(ctx, article.EditArticleRequest{ID: 1, Title: "test", Subtitle: "test", Content: "rewritten after approval"})
 
+	assert.Equal(t, exception.ErrConflicted, resp.Err())
+	articleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecaseEdit_DraftIsNotModerated(t *testing.T) {
+	moderator := new(moderationMocks.ContentModerator)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft, Author: entity.Account{ID: 1}}, nil)
+	articleRepo.On("Update", mock.Anything, int64(1), int64(1), mock.AnythingOfType("article.Article")).Return(nil)
+
+	linkRepo := new(articleMocks.ArticleLinkRepository)
+	linkRepo.On("ReplaceLinks", mock.Anything, int64(1), []int64{}).Return(nil)
+
+	u := editArticleUsecase(articleRepo, linkRepo, moderator)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.Edit(ctx, article.EditArticleRequest{ID: 1, Title: "test", Subtitle: "test", Content: "test"})
+	assert.NoError(t, resp.Err())
+
+	moderator.AssertNotCalled(t, "Moderate", mock.Anything, mock.Anything)
 }
 
 func TestUsecaseGetAllPublic_Success(t *testing.T) {
@@ -97,7 +232,7 @@ func TestUsecaseGetAllPublic_Success(t *testing.T) {
 			Title:     "test",
 			Subtitle:  "test",
 			Content:   "test",
-			Status:    article.ArticleStatusDraft,
+			Status:    article.ArticleStatusPublished,
 			CreatedAt: time.Now().In(location),
 			Author: entity.Account{
 				ID: 1,
@@ -109,13 +244,13 @@ func TestUsecaseGetAllPublic_Success(t *testing.T) {
 	crypto := new(cryptoMocks.Crypto)
 	accountRepo := new(accountMocks.AccountRepository)
 	articleRepo := new(articleMocks.ArticleRepository)
-	articleRepo.On("FindMany",
-		mock.Anything).Return(articles, nil)
+	articleRepo.On("FindManyByStatus",
+		mock.Anything, article.ArticleStatusPublished).Return(articles, nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
-	resp := u.GetAllPublic(ctx)
+	resp := u.GetAllPublic(ctx, article.ListArticleRequest{})
 	assert.NoError(t, resp.Err())
 
 	sess.AssertExpectations(t)
@@ -126,6 +261,46 @@ func TestUsecaseGetAllPublic_Success(t *testing.T) {
 
 }
 
+func TestUsecaseGetAllPublic_ExpandAuthor(t *testing.T) {
+	var articles = []article.Article{
+		{ID: 1, Title: "first", Status: article.ArticleStatusPublished, CreatedAt: time.Now().In(location), Author: entity.Account{ID: 1}},
+		{ID: 2, Title: "second", Status: article.ArticleStatusPublished, CreatedAt: time.Now().In(location), Author: entity.Account{ID: 2}},
+		{ID: 3, Title: "third", Status: article.ArticleStatusPublished, CreatedAt: time.Now().In(location), Author: entity.Account{ID: 1}},
+	}
+	sess := new(sessionMocks.Session)
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	crypto := new(cryptoMocks.Crypto)
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByIDs", mock.Anything, []int64{1, 2}).Return([]entity.Account{
+		{ID: 1, FirstName: "John", LastName: "Doe", Handle: "johndoe"},
+		{ID: 2, FirstName: "Jane", LastName: "Doe"},
+	}, nil).Once()
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindManyByStatus", mock.Anything, article.ArticleStatusPublished).Return(articles, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+
+	resp := u.GetAllPublic(context.TODO(), article.ListArticleRequest{Expand: []string{article.ArticleExpandAuthor}})
+	assert.NoError(t, resp.Err())
+
+	recorder := httptest.NewRecorder()
+	resp.JSON(recorder)
+
+	var body struct {
+		Data []article.GetArticleResponse `json:"data"`
+	}
+	json.NewDecoder(recorder.Body).Decode(&body)
+
+	assert.Len(t, body.Data, 3)
+	assert.Equal(t, "John Doe", body.Data[0].Author.Name)
+	assert.Equal(t, "johndoe", body.Data[0].Author.Handle)
+	assert.Equal(t, "Jane Doe", body.Data[1].Author.Name)
+	assert.Equal(t, int64(1), body.Data[2].Author.ID)
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+}
+
 func TestUsecaseGetAllPrivate_Success(t *testing.T) {
 	var articles = []article.Article{
 		{
@@ -150,10 +325,10 @@ func TestUsecaseGetAllPrivate_Success(t *testing.T) {
 	articleRepo.On("FindManySpecificProfile",
 		mock.Anything, mock.AnythingOfType("int64")).Return(articles, nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
-	resp := u.GetAllPrivate(ctx)
+	resp := u.GetAllPrivate(ctx, article.ListArticleRequest{})
 	assert.NoError(t, resp.Err())
 
 	sess.AssertExpectations(t)
@@ -198,9 +373,10 @@ func TestUsecaseEditStatus_SuccessPublished(t *testing.T) {
 		mock.Anything,
 		mock.AnythingOfType("int64"),
 		mock.AnythingOfType("int64"),
+		mock.AnythingOfType("article.ArticleStatus"),
 		mock.AnythingOfType("article.Article")).Return(nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
 	params := article.EditStatusArticleRequest{
@@ -253,9 +429,10 @@ func TestUsecaseEditStatus_SuccessArchived(t *testing.T) {
 		mock.Anything,
 		mock.AnythingOfType("int64"),
 		mock.AnythingOfType("int64"),
+		mock.AnythingOfType("article.ArticleStatus"),
 		mock.AnythingOfType("article.Article")).Return(nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
 	params := article.EditStatusArticleRequest{
@@ -274,6 +451,31 @@ func TestUsecaseEditStatus_SuccessArchived(t *testing.T) {
 
 }
 
+func TestUsecaseEditStatus_ChangedMeanwhile(t *testing.T) {
+	dataArticle := article.Article{ID: 1, Title: "test", Status: article.ArticleStatusPublished, Author: entity.Account{ID: 1}}
+
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "email@gmail.co").Return(entity.Account{ID: 1}, nil)
+
+	//Another request archived the article after it was read
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(dataArticle, nil)
+	articleRepo.On("UpdateStatus", mock.Anything, int64(1), int64(1), article.ArticleStatusPublished, mock.AnythingOfType("article.Article")).Return(exception.ErrConflicted)
+
+	u := article.NewArticleUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.EditStatus(ctx, article.EditStatusArticleRequest{ID: 1, Status: article.ArticleStatusUnlisted})
+
+	assert.Equal(t, exception.ErrConflicted, resp.Err())
+
+	recorder := httptest.NewRecorder()
+	resp.JSON(recorder)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	articleRepo.AssertExpectations(t)
+}
+
 func TestUsecaseGetOne_Success(t *testing.T) {
 
 	sess := new(sessionMocks.Session)
@@ -284,7 +486,7 @@ func TestUsecaseGetOne_Success(t *testing.T) {
 	articleRepo.On("FindByID",
 		mock.Anything, mock.AnythingOfType("int64")).Return(article.Article{}, nil)
 
-	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo)
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
 	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
 
 	params := article.GetOneArticleRequest{
@@ -301,3 +503,231 @@ func TestUsecaseGetOne_Success(t *testing.T) {
 	articleRepo.AssertExpectations(t)
 
 }
+
+func TestUsecaseGetOne_Translated(t *testing.T) {
+
+	sess := new(sessionMocks.Session)
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	crypto := new(cryptoMocks.Crypto)
+	accountRepo := new(accountMocks.AccountRepository)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID",
+		mock.Anything, int64(1)).Return(article.Article{ID: 1, Title: "judul", Language: "id"}, nil)
+	translationRepo := new(articleMocks.ArticleTranslationRepository)
+	translationRepo.On("FindByArticle",
+		mock.Anything, int64(1)).Return([]article.ArticleTranslation{{ArticleID: 1, Language: "en", Title: "title"}}, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), translationRepo, article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	params := article.GetOneArticleRequest{
+		ID:        1,
+		Languages: []string{"en-us"},
+	}
+
+	resp := u.GetOne(ctx, params)
+	assert.NoError(t, resp.Err())
+
+	recorder := httptest.NewRecorder()
+	resp.JSON(recorder)
+
+	var body struct {
+		Data article.Article `json:"data"`
+	}
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+	assert.Equal(t, "title", body.Data.Title)
+	assert.Equal(t, "en", body.Data.Language)
+
+	sess.AssertExpectations(t)
+	jsonWebToken.AssertExpectations(t)
+	crypto.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+	translationRepo.AssertExpectations(t)
+
+}
+
+func TestUsecaseGetOne_AnonymousMembersArticle(t *testing.T) {
+
+	sess := new(sessionMocks.Session)
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	crypto := new(cryptoMocks.Crypto)
+	accountRepo := new(accountMocks.AccountRepository)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID",
+		mock.Anything, int64(1)).Return(article.Article{
+		ID:          1,
+		Content:     strings.Repeat("members only ", 100),
+		AccessLevel: article.ArticleAccessLevelMembers,
+		Status:      article.ArticleStatusPublished,
+	}, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+
+	resp := u.GetOne(context.Background(), article.GetOneArticleRequest{ID: 1})
+	assert.NoError(t, resp.Err())
+
+	recorder := httptest.NewRecorder()
+	resp.JSON(recorder)
+
+	var body struct {
+		Data article.Article `json:"data"`
+	}
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+	assert.True(t, body.Data.Locked)
+	assert.True(t, len([]rune(body.Data.Content)) <= article.ArticleExcerptLength+1)
+
+	sess.AssertExpectations(t)
+	jsonWebToken.AssertExpectations(t)
+	crypto.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+
+}
+
+func TestUsecaseGetOne_AnonymousDraft(t *testing.T) {
+
+	sess := new(sessionMocks.Session)
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	crypto := new(cryptoMocks.Crypto)
+	accountRepo := new(accountMocks.AccountRepository)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID",
+		mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft}, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+
+	resp := u.GetOne(context.Background(), article.GetOneArticleRequest{ID: 1})
+	assert.Error(t, resp.Err())
+
+	sess.AssertExpectations(t)
+	jsonWebToken.AssertExpectations(t)
+	crypto.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+
+}
+
+func TestUsecaseEditStatus_InvalidTransition(t *testing.T) {
+	var dataArticle = article.Article{
+		ID:        1,
+		Title:     "test",
+		Subtitle:  "test",
+		Content:   "test",
+		Status:    article.ArticleStatusDraft,
+		CreatedAt: time.Now().In(location),
+		Author: entity.Account{
+			ID: 1,
+		},
+	}
+
+	var dataAcc = entity.Account{
+		ID:        1,
+		Email:     "mail@mail.c",
+		FirstName: "Pablo",
+		LastName:  "Picasso",
+		CreatedAt: time.Now().In(location),
+	}
+	sess := new(sessionMocks.Session)
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	crypto := new(cryptoMocks.Crypto)
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(dataAcc, nil)
+
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID",
+		mock.Anything, mock.AnythingOfType("int64")).Return(dataArticle, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", sess, jsonWebToken, crypto, location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	params := article.EditStatusArticleRequest{
+		ID:     1,
+		Status: "ARCHIVED",
+	}
+
+	resp := u.EditStatus(ctx, params)
+	assert.Error(t, resp.Err())
+
+	sess.AssertExpectations(t)
+	jsonWebToken.AssertExpectations(t)
+	crypto.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+
+}
+
+func TestUsecaseGetSummary_FillsWeeks(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "email@gmail.co").Return(entity.Account{ID: 3}, nil)
+
+	var since time.Time
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("SummarizeByAuthor", mock.Anything, int64(3), mock.AnythingOfType("time.Time"), 5).Run(func(args mock.Arguments) {
+		since = args.Get(2).(time.Time)
+	}).Return(func(ctx context.Context, authorId int64, publishedSince time.Time, limit int) article.AuthorSummary {
+		return article.AuthorSummary{
+			CountByStatus:   map[article.ArticleStatus]int{article.ArticleStatusPublished: 2},
+			WeeklyPublished: []article.WeeklyPublishCount{{WeekStart: publishedSince.AddDate(0, 0, 7), Published: 2}},
+		}
+	}, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.GetSummary(ctx, article.ArticleSummaryRequest{Weeks: 4})
+	assert.NoError(t, resp.Err())
+
+	rec := httptest.NewRecorder()
+	resp.JSON(rec)
+
+	var body struct {
+		Data article.AuthorSummary `json:"data"`
+	}
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+
+	assert.Equal(t, time.Monday, since.Weekday())
+	assert.Len(t, body.Data.WeeklyPublished, 4)
+	assert.Equal(t, []int{0, 2, 0, 0}, []int{
+		body.Data.WeeklyPublished[0].Published,
+		body.Data.WeeklyPublished[1].Published,
+		body.Data.WeeklyPublished[2].Published,
+		body.Data.WeeklyPublished[3].Published,
+	})
+	assert.True(t, since.AddDate(0, 0, 21).Before(time.Now()))
+}
+
+func TestUsecaseGetOne_DraftOfAnotherAuthor(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "email@gmail.co").Return(entity.Account{ID: 2}, nil)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft, Author: entity.Account{ID: 1}}, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.GetOne(ctx, article.GetOneArticleRequest{ID: 1})
+
+	recorder := httptest.NewRecorder()
+	resp.JSON(recorder)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+}
+
+func TestUsecaseGetOne_DraftOfItsAuthor(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "email@gmail.co").Return(entity.Account{ID: 1}, nil)
+	articleRepo := new(articleMocks.ArticleRepository)
+	articleRepo.On("FindByID", mock.Anything, int64(1)).Return(article.Article{ID: 1, Status: article.ArticleStatusDraft, Author: entity.Account{ID: 1}}, nil)
+
+	u := article.NewArticleUsecase("globalIVTest", new(sessionMocks.Session), new(jsonWebTokenMocks.JSONWebToken), new(cryptoMocks.Crypto), location, articleRepo, accountRepo, article.NewArticleStateMachine(), new(articleMocks.ArticleTranslationRepository), article.NewDuplicateDetector(location, new(articleMocks.DuplicateRepository)), viewCounter(), article.NewLinkGraph(baseURL, new(articleMocks.ArticleLinkRepository)), article.NewModerationWorkflow(moderation.NewChain()))
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "email@gmail.co")
+
+	resp := u.GetOne(ctx, article.GetOneArticleRequest{ID: 1})
+	assert.NoError(t, resp.Err())
+
+	accountRepo.AssertExpectations(t)
+	articleRepo.AssertExpectations(t)
+}
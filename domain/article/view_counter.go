@@ -0,0 +1,112 @@
+package article
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+)
+
+// ArticleViewWindow is how long repeated reads of an article by the same viewer count as one view.
+const ArticleViewWindow = time.Minute * 30
+
+// ArticleView is a read of an article waiting to be counted.
+type ArticleView struct {
+	ArticleID int64
+	AuthorID  int64
+	// Email is the signed in reader, empty for anonymous ones.
+	Email string
+	// Viewer tells readers apart, the account when signed in and the address and user agent otherwise.
+	Viewer     string
+	OccurredAt time.Time
+}
+
+func newArticleView(ctx context.Context, article Article, at time.Time) (view ArticleView) {
+	view.ArticleID = article.ID
+	view.AuthorID = article.Author.ID
+	view.OccurredAt = at
+
+	if email, ok := ctx.Value(entity.EmailCtx).(string); ok && email != "" {
+		view.Email = email
+		view.Viewer = "account:" + email
+		return
+	}
+
+	info := middleware.RequestInfoFromContext(ctx)
+	view.Viewer = "anonymous:" + info.IP + " " + info.UserAgent
+
+	return
+}
+
+// ViewCounter counts the reads of articles towards trending off the request path,
+// once per viewer and window and never the reads of the author.
+type ViewCounter struct {
+	window      time.Duration
+	throttle    ViewThrottle
+	repository  EngagementRepository
+	accountRepo account.AccountRepository
+	views       chan ArticleView
+}
+
+// NewViewCounter is a constructor, the queue holds the views not counted yet.
+func NewViewCounter(window time.Duration, queueSize int, throttle ViewThrottle, repository EngagementRepository, accountRepo account.AccountRepository) *ViewCounter {
+	return &ViewCounter{
+		window:      window,
+		throttle:    throttle,
+		repository:  repository,
+		accountRepo: accountRepo,
+		views:       make(chan ArticleView, queueSize),
+	}
+}
+
+// Count queues the view without waiting. A lost view only makes the ranking slightly less accurate,
+// so a view that finds the queue full is dropped.
+func (c *ViewCounter) Count(view ArticleView) {
+	select {
+	case c.views <- view:
+	default:
+		log.Printf("view of article %d dropped, the queue is full\n", view.ArticleID)
+	}
+}
+
+// Run counts the queued views until the context is done.
+func (c *ViewCounter) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case view := <-c.views:
+			if err := c.record(ctx, view); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+func (c *ViewCounter) record(ctx context.Context, view ArticleView) (err error) {
+	if view.Email != "" {
+		reader, err := c.accountRepo.FindByEmail(ctx, view.Email)
+		if err != nil && err != exception.ErrNotFound {
+			return err
+		}
+		if err == nil && reader.ID == view.AuthorID {
+			return nil
+		}
+	}
+
+	ok, err := c.throttle.Allow(ctx, view.ArticleID, view.Viewer, c.window)
+	if err != nil || !ok {
+		return
+	}
+
+	engagement := ArticleEngagement{}
+	engagement.ArticleID = view.ArticleID
+	engagement.Kind = EngagementKindView
+	engagement.OccurredAt = view.OccurredAt
+
+	return c.repository.Record(ctx, engagement)
+}
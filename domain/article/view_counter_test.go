@@ -0,0 +1,67 @@
+package article_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+)
+
+func TestViewCounterRun_CountsOncePerViewerAndNeverTheAuthor(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1}, nil)
+
+	throttle := new(articleMocks.ViewThrottle)
+	throttle.On("Allow", mock.Anything, int64(2), "anonymous:10.0.0.1 curl", article.ArticleViewWindow).Return(false, nil).Once()
+	throttle.On("Allow", mock.Anything, int64(3), "anonymous:10.0.0.1 curl", article.ArticleViewWindow).Return(true, nil).Once()
+
+	counted := make(chan struct{})
+	engagementRepo := new(articleMocks.EngagementRepository)
+	engagementRepo.On("Record", mock.Anything, mock.MatchedBy(func(engagement article.ArticleEngagement) bool {
+		return engagement.ArticleID == 3 && engagement.Kind == article.EngagementKindView
+	})).Return(nil).Run(func(args mock.Arguments) {
+		close(counted)
+	})
+
+	counter := article.NewViewCounter(article.ArticleViewWindow, 4, throttle, engagementRepo, accountRepo)
+	//The author reading their own article, a reader seen within the window and a new one
+	counter.Count(article.ArticleView{ArticleID: 1, AuthorID: 1, Email: "john.doe@email.com", Viewer: "account:john.doe@email.com"})
+	counter.Count(article.ArticleView{ArticleID: 2, AuthorID: 1, Viewer: "anonymous:10.0.0.1 curl"})
+	counter.Count(article.ArticleView{ArticleID: 3, AuthorID: 1, Viewer: "anonymous:10.0.0.1 curl"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go counter.Run(ctx)
+
+	select {
+	case <-counted:
+	case <-time.After(time.Second):
+		t.Fatal("the view was not counted")
+	}
+
+	engagementRepo.AssertNumberOfCalls(t, "Record", 1)
+	throttle.AssertExpectations(t)
+}
+
+func TestViewCounterCount_DropsWhenTheQueueIsFull(t *testing.T) {
+	counter := article.NewViewCounter(article.ArticleViewWindow, 1, new(articleMocks.ViewThrottle), new(articleMocks.EngagementRepository), new(accountMocks.AccountRepository))
+
+	done := make(chan struct{})
+	go func() {
+		counter.Count(article.ArticleView{ArticleID: 1})
+		counter.Count(article.ArticleView{ArticleID: 2})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the reader waited on the queue")
+	}
+}
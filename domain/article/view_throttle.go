@@ -0,0 +1,47 @@
+package article
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	rv8 "github.com/go-redis/redis/v8"
+
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type ViewThrottle interface {
+	// Allow is true for the first view of an article by the viewer within the window.
+	Allow(ctx context.Context, articleID int64, viewer string, window time.Duration) (ok bool, err error)
+}
+
+type redisViewThrottle struct {
+	c         rv8.UniversalClient
+	keyPrefix string
+}
+
+func NewRedisViewThrottle(rdb rv8.UniversalClient, keyPrefix string) ViewThrottle {
+	return &redisViewThrottle{
+		c:         rdb,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// The viewer is hashed, the keys neither grow with long user agents nor expose who read what.
+func (t *redisViewThrottle) key(articleID int64, viewer string) string {
+	sum := sha256.Sum256([]byte(viewer))
+	return fmt.Sprintf("%s:%d:%s", t.keyPrefix, articleID, hex.EncodeToString(sum[:16]))
+}
+
+func (t *redisViewThrottle) Allow(ctx context.Context, articleID int64, viewer string, window time.Duration) (ok bool, err error) {
+	ok, err = t.c.SetNX(ctx, t.key(articleID, viewer), 1, window).Result()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+	}
+
+	return
+}
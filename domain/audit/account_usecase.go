@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// accountState is what the audit log keeps of an account, never the password.
+type accountState struct {
+	Email     string             `json:"email"`
+	FirstName string             `json:"firstName"`
+	LastName  string             `json:"lastName"`
+	Role      entity.AccountRole `json:"role"`
+}
+
+type mentionState struct {
+	Mentionable bool `json:"mentionable"`
+}
+
+type handleState struct {
+	Handle string `json:"handle"`
+}
+
+type auditedAccountUsecase struct {
+	account.AccountUsecase
+	recorder *Recorder
+}
+
+// NewAuditedAccountUsecase records registrations, sign ins and sign outs, which start or end a session,
+// and the changes of the account settings, reads pass through.
+func NewAuditedAccountUsecase(usecase account.AccountUsecase, recorder *Recorder) account.AccountUsecase {
+	return &auditedAccountUsecase{
+		AccountUsecase: usecase,
+		recorder:       recorder,
+	}
+}
+
+func (u *auditedAccountUsecase) Register(ctx context.Context, params account.AccountRegistrationRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.AccountUsecase.Register(ctx, params)
+		err = u.recordAuthentication(ctx, resp, ActionAccountRegister)
+
+		return
+	})
+}
+
+func (u *auditedAccountUsecase) Login(ctx context.Context, params account.AccountAuthenticationRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.AccountUsecase.Login(ctx, params)
+		err = u.recordAuthentication(ctx, resp, ActionAccountLogin)
+
+		return
+	})
+}
+
+func (u *auditedAccountUsecase) Logout(ctx context.Context, params account.LogoutRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.AccountUsecase.Logout(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		actor := u.recorder.Actor(ctx)
+		if actor == nil {
+			return
+		}
+
+		action := ActionAccountLogout
+		if params.AllDevices {
+			action = ActionAccountLogoutAll
+		}
+
+		err = u.recorder.Record(ctx, actor, Entry{
+			Action:     action,
+			TargetType: TargetAccount,
+			TargetID:   actor.ID,
+		})
+
+		return
+	})
+}
+
+func (u *auditedAccountUsecase) UpdateMentionPreference(ctx context.Context, params account.UpdateMentionPreferenceRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		before := u.recorder.Actor(ctx)
+
+		resp = u.AccountUsecase.UpdateMentionPreference(ctx, params)
+		if resp.Err() != nil || before == nil {
+			return
+		}
+
+		err = u.recorder.Record(ctx, before, Entry{
+			Action:     ActionAccountUpdateMentionPreference,
+			TargetType: TargetAccount,
+			TargetID:   before.ID,
+			Diff:       diff(mentionState{Mentionable: before.Mentionable}, mentionState{Mentionable: *params.Mentionable}),
+		})
+
+		return
+	})
+}
+
+func (u *auditedAccountUsecase) UpdateHandle(ctx context.Context, params account.UpdateHandleRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		before := u.recorder.Actor(ctx)
+
+		resp = u.AccountUsecase.UpdateHandle(ctx, params)
+		if resp.Err() != nil || before == nil {
+			return
+		}
+
+		err = u.recorder.Record(ctx, before, Entry{
+			Action:     ActionAccountUpdateHandle,
+			TargetType: TargetAccount,
+			TargetID:   before.ID,
+			Diff:       diff(handleState{Handle: before.Handle}, handleState{Handle: params.Handle}),
+		})
+
+		return
+	})
+}
+
+// recordAuthentication records the account of the response as its own actor, the request is not signed in yet.
+func (u *auditedAccountUsecase) recordAuthentication(ctx context.Context, resp response.Response, action Action) (err error) {
+	if resp.Err() != nil {
+		return
+	}
+
+	authenticated, ok := response.Data(resp).(account.AccountAuthenticationResponse)
+	if !ok {
+		return
+	}
+
+	profile := authenticated.Profile
+
+	var changes map[string]Change
+	if action == ActionAccountRegister {
+		changes = diff(nil, accountState{
+			Email:     profile.Email,
+			FirstName: profile.FirstName,
+			LastName:  profile.LastName,
+			Role:      profile.Role,
+		})
+	}
+
+	return u.recorder.Record(ctx, &profile, Entry{
+		Action:     action,
+		TargetType: TargetAccount,
+		TargetID:   profile.ID,
+		Diff:       changes,
+	})
+}
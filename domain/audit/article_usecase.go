@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// articleState is what the audit log compares of an article.
+type articleState struct {
+	Title       string                     `json:"title"`
+	Subtitle    string                     `json:"subtitle"`
+	Content     string                     `json:"content"`
+	Language    string                     `json:"language"`
+	AccessLevel article.ArticleAccessLevel `json:"accessLevel"`
+	Status      article.ArticleStatus      `json:"status"`
+	PublishedAt *time.Time                 `json:"publishedAt"`
+	AuthorID    int64                      `json:"authorId"`
+}
+
+func newArticleState(a article.Article) *articleState {
+	return &articleState{
+		Title:       a.Title,
+		Subtitle:    a.Subtitle,
+		Content:     a.Content,
+		Language:    a.Language,
+		AccessLevel: a.AccessLevel,
+		Status:      a.Status,
+		PublishedAt: a.PublishedAt,
+		AuthorID:    a.Author.ID,
+	}
+}
+
+type auditedArticleUsecase struct {
+	article.ArticleUsecase
+	recorder   *Recorder
+	repository article.ArticleRepository
+}
+
+// NewAuditedArticleUsecase records every successful mutation of the article usecase, reads pass through.
+func NewAuditedArticleUsecase(usecase article.ArticleUsecase, recorder *Recorder, repository article.ArticleRepository) article.ArticleUsecase {
+	return &auditedArticleUsecase{
+		ArticleUsecase: usecase,
+		recorder:       recorder,
+		repository:     repository,
+	}
+}
+
+func (u *auditedArticleUsecase) Create(ctx context.Context, params article.CreateArticleRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.ArticleUsecase.Create(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		created, ok := response.Data(resp).(article.CreatedArticleResponse)
+		if !ok {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionArticleCreate,
+			TargetType: TargetArticle,
+			TargetID:   created.ID,
+			Diff:       diff(nil, newArticleState(created.Article)),
+		})
+
+		return
+	})
+}
+
+func (u *auditedArticleUsecase) Edit(ctx context.Context, params article.EditArticleRequest) (resp response.Response) {
+	return u.mutate(ctx, ActionArticleEdit, params.ID, func(ctx context.Context) response.Response {
+		return u.ArticleUsecase.Edit(ctx, params)
+	})
+}
+
+func (u *auditedArticleUsecase) EditStatus(ctx context.Context, params article.EditStatusArticleRequest) (resp response.Response) {
+	return u.mutate(ctx, ActionArticleEditStatus, params.ID, func(ctx context.Context) response.Response {
+		return u.ArticleUsecase.EditStatus(ctx, params)
+	})
+}
+
+// mutate records the article as it was before and after a successful call.
+func (u *auditedArticleUsecase) mutate(ctx context.Context, action Action, ID int64, call func(ctx context.Context) response.Response) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		var before interface{}
+		if existing, err := u.repository.FindByID(ctx, ID); err == nil {
+			before = newArticleState(existing)
+		}
+
+		resp = call(ctx)
+		if resp.Err() != nil {
+			return
+		}
+
+		var after interface{}
+		if updated, err := u.repository.FindByID(ctx, ID); err == nil {
+			after = newArticleState(updated)
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     action,
+			TargetType: TargetArticle,
+			TargetID:   ID,
+			Diff:       diff(before, after),
+		})
+
+		return
+	})
+}
@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Action is a type of audited mutation.
+type Action string
+
+const (
+	ActionArticleCreate     Action = "article.create"
+	ActionArticleEdit       Action = "article.edit"
+	ActionArticleEditStatus Action = "article.edit_status"
+	ActionAccountRegister   Action = "account.register"
+	ActionAccountLogin      Action = "account.login"
+	ActionAccountLogout     Action = "account.logout"
+	ActionAccountLogoutAll  Action = "account.logout_all"
+
+	ActionAccountUpdateMentionPreference Action = "account.update_mention_preference"
+	ActionAccountUpdateHandle            Action = "account.update_handle"
+
+	ActionTranslationUpsert    Action = "article.translation_upsert"
+	ActionTranslationDelete    Action = "article.translation_delete"
+	ActionArticlePin           Action = "article.pin"
+	ActionArticleUnpin         Action = "article.unpin"
+	ActionPreviewLinkCreate    Action = "article.preview_link_create"
+	ActionPreviewLinkRevoke    Action = "article.preview_link_revoke"
+	ActionReviewClaim          Action = "review.claim"
+	ActionReviewAddNote        Action = "review.add_note"
+	ActionReviewApprove        Action = "review.approve"
+	ActionReviewRequestChanges Action = "review.request_changes"
+	ActionWebhookCreate        Action = "webhook.create"
+	ActionWebhookDelete        Action = "webhook.delete"
+	ActionWebhookRedeliver     Action = "webhook.redeliver"
+)
+
+// Targets of the audited mutations.
+const (
+	TargetArticle = "article"
+	TargetAccount = "account"
+	TargetReview  = "review"
+	TargetWebhook = "webhook"
+)
+
+// GenesisHash is the previous hash of the first entry of the chain.
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// Change is the value of a property before and after a mutation.
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Entry is one row of the audit log. Every entry carries the hash of the one before,
+// so editing or removing a row breaks the chain from that row on.
+type Entry struct {
+	ID         int64             `json:"id"`
+	ActorID    *int64            `json:"actorId"`
+	ActorEmail string            `json:"actorEmail"`
+	Action     Action            `json:"action"`
+	TargetType string            `json:"targetType"`
+	TargetID   int64             `json:"targetId"`
+	Diff       map[string]Change `json:"diff"`
+	IP         string            `json:"ip"`
+	UserAgent  string            `json:"userAgent"`
+	RequestID  string            `json:"requestId"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	PrevHash   string            `json:"prevHash"`
+	Hash       string            `json:"hash"`
+}
+
+// ComputeHash hashes the entry content together with the previous hash.
+// The time is hashed in UTC with millisecond precision, the precision the table keeps.
+func (e Entry) ComputeHash() string {
+	var actorID string
+	if e.ActorID != nil {
+		actorID = strconv.FormatInt(*e.ActorID, 10)
+	}
+
+	diff, _ := json.Marshal(e.Diff)
+
+	content, _ := json.Marshal([]string{
+		e.PrevHash,
+		actorID,
+		e.ActorEmail,
+		string(e.Action),
+		e.TargetType,
+		strconv.FormatInt(e.TargetID, 10),
+		string(diff),
+		e.IP,
+		e.UserAgent,
+		e.RequestID,
+		e.CreatedAt.UTC().Truncate(time.Millisecond).Format("2006-01-02T15:04:05.000Z"),
+	})
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Diff lists the JSON properties that differ between two states, a nil before records a creation.
+func Diff(before, after interface{}) (diff map[string]Change, err error) {
+	beforeProperties, err := properties(before)
+	if err != nil {
+		return
+	}
+	afterProperties, err := properties(after)
+	if err != nil {
+		return
+	}
+
+	diff = make(map[string]Change)
+	for key, value := range afterProperties {
+		previous, ok := beforeProperties[key]
+		if !ok || !reflect.DeepEqual(previous, value) {
+			diff[key] = Change{Before: previous, After: value}
+		}
+	}
+	for key, previous := range beforeProperties {
+		if _, ok := afterProperties[key]; !ok {
+			diff[key] = Change{Before: previous}
+		}
+	}
+
+	return
+}
+
+func properties(state interface{}) (props map[string]interface{}, err error) {
+	if state == nil {
+		return
+	}
+
+	encoded, err := json.Marshal(state)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(encoded, &props)
+	return
+}
+
+// Verification is the result of walking the chain.
+type Verification struct {
+	Valid    bool   `json:"valid"`
+	Checked  int    `json:"checked"`
+	LastHash string `json:"lastHash,omitempty"`
+	BrokenAt *int64 `json:"brokenAt,omitempty"`
+}
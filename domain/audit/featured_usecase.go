@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// featuredState is what the audit log compares of a homepage slot.
+type featuredState struct {
+	Position  int       `json:"position"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type auditedFeaturedUsecase struct {
+	article.FeaturedUsecase
+	recorder   *Recorder
+	repository article.FeaturedRepository
+}
+
+// NewAuditedFeaturedUsecase records the articles pinned to and unpinned from the homepage, reads pass through.
+func NewAuditedFeaturedUsecase(usecase article.FeaturedUsecase, recorder *Recorder, repository article.FeaturedRepository) article.FeaturedUsecase {
+	return &auditedFeaturedUsecase{
+		FeaturedUsecase: usecase,
+		recorder:        recorder,
+		repository:      repository,
+	}
+}
+
+func (u *auditedFeaturedUsecase) Pin(ctx context.Context, params article.PinArticleRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		before := u.find(ctx, params.ArticleID)
+
+		resp = u.FeaturedUsecase.Pin(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		featured, ok := response.Data(resp).(article.FeaturedArticle)
+		if !ok {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionArticlePin,
+			TargetType: TargetArticle,
+			TargetID:   params.ArticleID,
+			Diff:       diff(before, featuredState{Position: featured.Position, ExpiresAt: featured.ExpiresAt}),
+		})
+
+		return
+	})
+}
+
+func (u *auditedFeaturedUsecase) Unpin(ctx context.Context, params article.UnpinArticleRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		before := u.find(ctx, params.ArticleID)
+
+		resp = u.FeaturedUsecase.Unpin(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionArticleUnpin,
+			TargetType: TargetArticle,
+			TargetID:   params.ArticleID,
+			Diff:       diff(before, nil),
+		})
+
+		return
+	})
+}
+
+// find is the slot the article is pinned to, nil when it is not featured.
+func (u *auditedFeaturedUsecase) find(ctx context.Context, articleID int64) interface{} {
+	featured, err := u.repository.FindActive(ctx, time.Now())
+	if err != nil {
+		return nil
+	}
+
+	for _, slot := range featured {
+		if slot.ArticleID == articleID {
+			return &featuredState{Position: slot.Position, ExpiresAt: slot.ExpiresAt}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type AuditHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  AuditUsecase
+}
+
+func NewAuditHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase AuditUsecase,
+) {
+	handler := &AuditHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/audit-logs", bearerAuthMiddleware.VerifyBearer(handler.GetAll)).Methods(http.MethodGet)
+	router.HandleFunc("/v1/audit-logs/verify", bearerAuthMiddleware.VerifyBearer(handler.Verify)).Methods(http.MethodGet)
+}
+
+func (handler *AuditHTTPHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params ListAuditLogRequest
+	var ctx = r.Context()
+	var err error
+	query := r.URL.Query()
+
+	params.Action = Action(query.Get("action"))
+	params.TargetType = query.Get("targetType")
+
+	for name, target := range map[string]*int64{"actorId": &params.ActorID, "targetId": &params.TargetID} {
+		if value := query.Get(name); value != "" {
+			*target, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+				resp.JSON(w)
+				return
+			}
+		}
+	}
+
+	for name, target := range map[string]*int{"page": &params.Page, "size": &params.Size} {
+		if value := query.Get(name); value != "" {
+			*target, err = strconv.Atoi(value)
+			if err != nil {
+				resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+				resp.JSON(w)
+				return
+			}
+		}
+	}
+
+	for name, target := range map[string]**time.Time{"from": &params.From, "to": &params.To} {
+		if value := query.Get(name); value != "" {
+			at, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+				resp.JSON(w)
+				return
+			}
+			*target = &at
+		}
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetAll(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *AuditHTTPHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var ctx = r.Context()
+
+	resp = handler.Usecase.Verify(ctx)
+	resp.JSON(w)
+}
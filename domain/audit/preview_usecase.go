@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// previewLinkState is what the audit log keeps of a preview link, never its token.
+type previewLinkState struct {
+	LinkID    string     `json:"linkId"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Revoked   bool       `json:"revoked"`
+}
+
+type auditedPreviewLinkUsecase struct {
+	article.PreviewLinkUsecase
+	recorder *Recorder
+}
+
+// NewAuditedPreviewLinkUsecase records the preview links issued and revoked, reads and views pass through.
+func NewAuditedPreviewLinkUsecase(usecase article.PreviewLinkUsecase, recorder *Recorder) article.PreviewLinkUsecase {
+	return &auditedPreviewLinkUsecase{
+		PreviewLinkUsecase: usecase,
+		recorder:           recorder,
+	}
+}
+
+func (u *auditedPreviewLinkUsecase) Create(ctx context.Context, params article.CreatePreviewLinkRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.PreviewLinkUsecase.Create(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		created, ok := response.Data(resp).(article.CreatePreviewLinkResponse)
+		if !ok {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionPreviewLinkCreate,
+			TargetType: TargetArticle,
+			TargetID:   params.ArticleID,
+			Diff:       diff(nil, previewLinkState{LinkID: created.ID, ExpiresAt: &created.ExpiresAt}),
+		})
+
+		return
+	})
+}
+
+func (u *auditedPreviewLinkUsecase) Revoke(ctx context.Context, params article.RevokePreviewLinkRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.PreviewLinkUsecase.Revoke(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		//Only a link still in use can be revoked
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionPreviewLinkRevoke,
+			TargetType: TargetArticle,
+			TargetID:   params.ArticleID,
+			Diff:       diff(previewLinkState{LinkID: params.LinkID}, previewLinkState{LinkID: params.LinkID, Revoked: true}),
+		})
+
+		return
+	})
+}
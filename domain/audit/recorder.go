@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// Recorder appends entries for the audited usecases, stamped with the request they came from.
+type Recorder struct {
+	location    *time.Location
+	transactor  database.Transactor
+	repository  AuditRepository
+	accountRepo account.AccountRepository
+}
+
+func NewRecorder(location *time.Location, transactor database.Transactor, repository AuditRepository, accountRepo account.AccountRepository) *Recorder {
+	return &Recorder{
+		location:    location,
+		transactor:  transactor,
+		repository:  repository,
+		accountRepo: accountRepo,
+	}
+}
+
+// Within runs the mutation in one transaction with the entries it records, so none of its changes are kept
+// when they cannot be recorded. A failed mutation keeps whatever it wrote, as it would without the audit log.
+func (r *Recorder) Within(ctx context.Context, mutation func(ctx context.Context) (resp response.Response, err error)) (resp response.Response) {
+	err := r.transactor.Within(ctx, func(ctx context.Context) (err error) {
+		resp, err = mutation(ctx)
+		return
+	})
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return
+}
+
+// Actor is the signed in account of the context, nil when the request is not signed in.
+func (r *Recorder) Actor(ctx context.Context) *entity.Account {
+	email, ok := ctx.Value(entity.EmailCtx).(string)
+	if !ok || email == "" {
+		return nil
+	}
+
+	actor, err := r.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return &entity.Account{Email: email}
+	}
+
+	return &actor
+}
+
+// Record appends the entry, within the transaction of the mutation when called from Within.
+func (r *Recorder) Record(ctx context.Context, actor *entity.Account, entry Entry) (err error) {
+	if actor != nil {
+		entry.ActorEmail = actor.Email
+		if actor.ID > 0 {
+			actorID := actor.ID
+			entry.ActorID = &actorID
+		}
+	}
+
+	info := middleware.RequestInfoFromContext(ctx)
+	entry.IP = info.IP
+	entry.UserAgent = info.UserAgent
+	entry.RequestID = info.RequestID
+	entry.CreatedAt = time.Now().In(r.location)
+
+	if _, err = r.repository.Append(ctx, entry); err != nil {
+		log.Printf("audit: %s on %s %d was not recorded: %v\n", entry.Action, entry.TargetType, entry.TargetID, err)
+	}
+
+	return
+}
+
+// diff is Diff for the decorators, a state that cannot be compared is logged and left out.
+func diff(before, after interface{}) map[string]Change {
+	changes, err := Diff(before, after)
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+
+	return changes
+}
@@ -0,0 +1,230 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+// AuditRepository only ever appends, the log has no update or delete.
+type AuditRepository interface {
+	Append(ctx context.Context, entry Entry) (saved Entry, err error)
+	FindMany(ctx context.Context, filter Filter) (entries []Entry, err error)
+	FindAfter(ctx context.Context, afterID int64, limit int) (entries []Entry, err error)
+}
+
+// Filter narrows the audit log query, zero values are not applied.
+type Filter struct {
+	ActorID    int64
+	Action     Action
+	TargetType string
+	TargetID   int64
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
+
+type auditRepositoryImpl struct {
+	db        *sql.DB
+	tableName string
+}
+
+func NewAuditRepository(db *sql.DB, tableName string) AuditRepository {
+	return &auditRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+const entryColumns = "id, actorId, actorEmail, action, targetType, targetId, diff, ip, userAgent, requestId, createdAt, prevHash, hash"
+
+// Append chains the entry to the last one. The last row is locked until the insert commits,
+// so concurrent appends line up behind each other instead of forking the chain.
+func (r *auditRepositoryImpl) Append(ctx context.Context, entry Entry) (saved Entry, err error) {
+	tx, err := database.Begin(ctx, r.db)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf(`SELECT hash FROM %s ORDER BY id DESC LIMIT 1 FOR UPDATE`, r.tableName)
+	err = tx.QueryRowContext(ctx, query).Scan(&entry.PrevHash)
+	if err == sql.ErrNoRows {
+		entry.PrevHash = GenesisHash
+		err = nil
+	}
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	entry.CreatedAt = entry.CreatedAt.Truncate(time.Millisecond)
+	entry.Hash = entry.ComputeHash()
+
+	diff, err := json.Marshal(entry.Diff)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	command := fmt.Sprintf(`INSERT INTO %s (actorId, actorEmail, action, targetType, targetId, diff, ip, userAgent, requestId, createdAt, prevHash, hash) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, r.tableName)
+	stmt, err := tx.PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		entry.ActorID,
+		entry.ActorEmail,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		diff,
+		entry.IP,
+		entry.UserAgent,
+		entry.RequestID,
+		entry.CreatedAt,
+		entry.PrevHash,
+		entry.Hash,
+	)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	entry.ID, _ = result.LastInsertId()
+
+	return entry, nil
+}
+
+func (r *auditRepositoryImpl) FindMany(ctx context.Context, filter Filter) (entries []Entry, err error) {
+	var conditions []string
+	var args []interface{}
+
+	if filter.ActorID > 0 {
+		conditions = append(conditions, "actorId = ?")
+		args = append(args, filter.ActorID)
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "action = ?")
+		args = append(args, filter.Action)
+	}
+	if filter.TargetType != "" {
+		conditions = append(conditions, "targetType = ?")
+		args = append(args, filter.TargetType)
+	}
+	if filter.TargetID > 0 {
+		conditions = append(conditions, "targetId = ?")
+		args = append(args, filter.TargetID)
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "createdAt >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "createdAt < ?")
+		args = append(args, *filter.To)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY id DESC LIMIT ? OFFSET ?`, entryColumns, r.tableName, where)
+	args = append(args, filter.Limit, filter.Offset)
+
+	return r.query(ctx, query, args...)
+}
+
+// FindAfter pages through the log from the oldest entry, it is what the chain is verified with.
+func (r *auditRepositoryImpl) FindAfter(ctx context.Context, afterID int64, limit int) (entries []Entry, err error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id > ? ORDER BY id LIMIT ?`, entryColumns, r.tableName)
+
+	return r.query(ctx, query, afterID, limit)
+}
+
+func (r *auditRepositoryImpl) query(ctx context.Context, query string, args ...interface{}) (entries []Entry, err error) {
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		entry := Entry{}
+		var actorID sql.NullInt64
+		var diff []byte
+
+		err = rows.Scan(
+			&entry.ID,
+			&actorID,
+			&entry.ActorEmail,
+			&entry.Action,
+			&entry.TargetType,
+			&entry.TargetID,
+			&diff,
+			&entry.IP,
+			&entry.UserAgent,
+			&entry.RequestID,
+			&entry.CreatedAt,
+			&entry.PrevHash,
+			&entry.Hash,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		if actorID.Valid {
+			entry.ActorID = &actorID.Int64
+		}
+
+		if err = json.Unmarshal(diff, &entry.Diff); err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/audit"
+)
+
+func TestRepositoryAppend_ChainsToLastEntry(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	lastHash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1 FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"hash"}).AddRow(lastHash))
+	mock.ExpectPrepare("INSERT INTO audit_log").
+		ExpectExec().
+		WithArgs(sqlmock.AnyArg(), "john.doe@email.com", audit.ActionArticleEdit, audit.TargetArticle, int64(3), sqlmock.AnyArg(), "10.0.0.1", "curl/7.68.0", "req-1", sqlmock.AnyArg(), lastHash, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(8, 1))
+	mock.ExpectCommit()
+
+	actorID := int64(1)
+	repository := audit.NewAuditRepository(db, "audit_log")
+	saved, err := repository.Append(context.TODO(), audit.Entry{
+		ActorID:    &actorID,
+		ActorEmail: "john.doe@email.com",
+		Action:     audit.ActionArticleEdit,
+		TargetType: audit.TargetArticle,
+		TargetID:   3,
+		Diff:       map[string]audit.Change{"title": {Before: "Old", After: "New"}},
+		IP:         "10.0.0.1",
+		UserAgent:  "curl/7.68.0",
+		RequestID:  "req-1",
+		CreatedAt:  time.Now(),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), saved.ID)
+	assert.Equal(t, lastHash, saved.PrevHash)
+	assert.Equal(t, saved.ComputeHash(), saved.Hash)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryAppend_FirstEntryUsesGenesis(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT hash FROM audit_log`).
+		WillReturnRows(sqlmock.NewRows([]string{"hash"}))
+	mock.ExpectPrepare("INSERT INTO audit_log").
+		ExpectExec().
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repository := audit.NewAuditRepository(db, "audit_log")
+	saved, err := repository.Append(context.TODO(), audit.Entry{Action: audit.ActionAccountLogin, TargetType: audit.TargetAccount, TargetID: 1, CreatedAt: time.Now()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, audit.GenesisHash, saved.PrevHash)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
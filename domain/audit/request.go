@@ -0,0 +1,15 @@
+package audit
+
+import "time"
+
+// ListAuditLogRequest is model for querying the audit log.
+type ListAuditLogRequest struct {
+	ActorID    int64      `json:"actorId" validate:"omitempty,min=1"`
+	Action     Action     `json:"action" validate:"omitempty,oneof=article.create article.edit article.edit_status article.translation_upsert article.translation_delete article.pin article.unpin article.preview_link_create article.preview_link_revoke account.register account.login account.logout account.logout_all account.update_mention_preference account.update_handle review.claim review.add_note review.approve review.request_changes webhook.create webhook.delete webhook.redeliver"`
+	TargetType string     `json:"targetType" validate:"omitempty,oneof=article account review webhook"`
+	TargetID   int64      `json:"targetId" validate:"omitempty,min=1"`
+	From       *time.Time `json:"from"`
+	To         *time.Time `json:"to"`
+	Page       int        `json:"page" validate:"omitempty,min=1"`
+	Size       int        `json:"size" validate:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// reviewState is what the audit log compares of a review, the comment is the one of the decision taken.
+type reviewState struct {
+	Status     article.ReviewStatus `json:"status"`
+	ReviewerID *int64               `json:"reviewerId"`
+	Comment    string               `json:"comment,omitempty"`
+}
+
+type noteState struct {
+	Quote string `json:"quote"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Body  string `json:"body"`
+}
+
+type auditedReviewUsecase struct {
+	article.ReviewUsecase
+	recorder   *Recorder
+	repository article.ReviewRepository
+}
+
+// NewAuditedReviewUsecase records the claims, notes and decisions of the editors, reads pass through.
+func NewAuditedReviewUsecase(usecase article.ReviewUsecase, recorder *Recorder, repository article.ReviewRepository) article.ReviewUsecase {
+	return &auditedReviewUsecase{
+		ReviewUsecase: usecase,
+		recorder:      recorder,
+		repository:    repository,
+	}
+}
+
+func (u *auditedReviewUsecase) Claim(ctx context.Context, params article.ClaimReviewRequest) (resp response.Response) {
+	return u.decide(ctx, ActionReviewClaim, params.ID, "", func(ctx context.Context) response.Response {
+		return u.ReviewUsecase.Claim(ctx, params)
+	})
+}
+
+func (u *auditedReviewUsecase) AddNote(ctx context.Context, params article.AddReviewNoteRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.ReviewUsecase.AddNote(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		note, ok := response.Data(resp).(article.ReviewNote)
+		if !ok {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionReviewAddNote,
+			TargetType: TargetReview,
+			TargetID:   params.ReviewID,
+			Diff:       diff(nil, noteState{Quote: note.Quote, Start: note.Start, End: note.End, Body: note.Body}),
+		})
+
+		return
+	})
+}
+
+func (u *auditedReviewUsecase) Approve(ctx context.Context, params article.DecideReviewRequest) (resp response.Response) {
+	return u.decide(ctx, ActionReviewApprove, params.ID, params.Comment, func(ctx context.Context) response.Response {
+		return u.ReviewUsecase.Approve(ctx, params)
+	})
+}
+
+func (u *auditedReviewUsecase) RequestChanges(ctx context.Context, params article.DecideReviewRequest) (resp response.Response) {
+	return u.decide(ctx, ActionReviewRequestChanges, params.ID, params.Comment, func(ctx context.Context) response.Response {
+		return u.ReviewUsecase.RequestChanges(ctx, params)
+	})
+}
+
+// decide records the review as it was before and after a successful call.
+func (u *auditedReviewUsecase) decide(ctx context.Context, action Action, ID int64, comment string, call func(ctx context.Context) response.Response) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		var before interface{}
+		if existing, err := u.repository.FindByID(ctx, ID); err == nil {
+			before = reviewState{Status: existing.Status, ReviewerID: existing.ReviewerID}
+		}
+
+		resp = call(ctx)
+		if resp.Err() != nil {
+			return
+		}
+
+		var after interface{}
+		if updated, err := u.repository.FindByID(ctx, ID); err == nil {
+			after = reviewState{Status: updated.Status, ReviewerID: updated.ReviewerID, Comment: comment}
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     action,
+			TargetType: TargetReview,
+			TargetID:   ID,
+			Diff:       diff(before, after),
+		})
+
+		return
+	})
+}
@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// translationState is what the audit log compares of a translation.
+type translationState struct {
+	Language string `json:"language"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+	Content  string `json:"content"`
+}
+
+type auditedTranslationUsecase struct {
+	article.TranslationUsecase
+	recorder   *Recorder
+	repository article.ArticleTranslationRepository
+}
+
+// NewAuditedTranslationUsecase records the translations added, replaced and removed, reads pass through.
+func NewAuditedTranslationUsecase(usecase article.TranslationUsecase, recorder *Recorder, repository article.ArticleTranslationRepository) article.TranslationUsecase {
+	return &auditedTranslationUsecase{
+		TranslationUsecase: usecase,
+		recorder:           recorder,
+		repository:         repository,
+	}
+}
+
+func (u *auditedTranslationUsecase) Upsert(ctx context.Context, params article.UpsertTranslationRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		before := u.find(ctx, params.ArticleID, params.Language)
+
+		resp = u.TranslationUsecase.Upsert(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionTranslationUpsert,
+			TargetType: TargetArticle,
+			TargetID:   params.ArticleID,
+			Diff:       diff(before, u.find(ctx, params.ArticleID, params.Language)),
+		})
+
+		return
+	})
+}
+
+func (u *auditedTranslationUsecase) Delete(ctx context.Context, params article.DeleteTranslationRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		before := u.find(ctx, params.ArticleID, params.Language)
+
+		resp = u.TranslationUsecase.Delete(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionTranslationDelete,
+			TargetType: TargetArticle,
+			TargetID:   params.ArticleID,
+			Diff:       diff(before, nil),
+		})
+
+		return
+	})
+}
+
+// find is the state of the translation in the language, nil when there is none.
+func (u *auditedTranslationUsecase) find(ctx context.Context, articleID int64, language string) interface{} {
+	translations, err := u.repository.FindByArticle(ctx, articleID)
+	if err != nil {
+		return nil
+	}
+
+	//The translations are stored under the normalized language tag
+	language = strings.ReplaceAll(strings.TrimSpace(language), "_", "-")
+	for _, translation := range translations {
+		if strings.EqualFold(translation.Language, language) {
+			return &translationState{
+				Language: translation.Language,
+				Title:    translation.Title,
+				Subtitle: translation.Subtitle,
+				Content:  translation.Content,
+			}
+		}
+	}
+
+	return nil
+}
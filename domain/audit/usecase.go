@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+const (
+	defaultPageSize = 20
+	verifyBatchSize = 500
+)
+
+type AuditUsecase interface {
+	GetAll(ctx context.Context, params ListAuditLogRequest) (resp response.Response)
+	Verify(ctx context.Context) (resp response.Response)
+}
+
+type auditUsecaseImpl struct {
+	repository  AuditRepository
+	accountRepo account.AccountRepository
+}
+
+func NewAuditUsecase(repository AuditRepository, accountRepo account.AccountRepository) AuditUsecase {
+	return &auditUsecaseImpl{
+		repository:  repository,
+		accountRepo: accountRepo,
+	}
+}
+
+func (u *auditUsecaseImpl) authorize(ctx context.Context) (resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if !account.HasRole(entity.AccountRoleAdmin) {
+		return response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest)
+	}
+
+	return nil
+}
+
+func (u *auditUsecaseImpl) GetAll(ctx context.Context, params ListAuditLogRequest) (resp response.Response) {
+	if resp = u.authorize(ctx); resp != nil {
+		return resp
+	}
+
+	size := params.Size
+	if size == 0 {
+		size = defaultPageSize
+	}
+	page := params.Page
+	if page == 0 {
+		page = 1
+	}
+
+	entries, err := u.repository.FindMany(ctx, Filter{
+		ActorID:    params.ActorID,
+		Action:     params.Action,
+		TargetType: params.TargetType,
+		TargetID:   params.TargetID,
+		From:       params.From,
+		To:         params.To,
+		Limit:      size,
+		Offset:     (page - 1) * size,
+	})
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, entries)
+}
+
+// Verify walks the whole chain and reports the first entry whose hash or link does not add up.
+// Dropping the newest entries leaves a valid chain, the checked count and last hash are what tell that apart.
+func (u *auditUsecaseImpl) Verify(ctx context.Context) (resp response.Response) {
+	if resp = u.authorize(ctx); resp != nil {
+		return resp
+	}
+
+	verification := Verification{Valid: true}
+	prevHash := GenesisHash
+	var lastID int64
+
+	for {
+		entries, err := u.repository.FindAfter(ctx, lastID, verifyBatchSize)
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+
+		for _, entry := range entries {
+			if entry.PrevHash != prevHash || entry.ComputeHash() != entry.Hash {
+				brokenAt := entry.ID
+				verification.Valid = false
+				verification.BrokenAt = &brokenAt
+				return response.Success(response.StatusOK, verification)
+			}
+
+			verification.Checked++
+			verification.LastHash = entry.Hash
+			prevHash = entry.Hash
+			lastID = entry.ID
+		}
+
+		if len(entries) < verifyBatchSize {
+			return response.Success(response.StatusOK, verification)
+		}
+	}
+}
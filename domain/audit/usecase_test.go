@@ -0,0 +1,311 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	databaseMocks "github.com/sangianpatrick/devoria-article-service/database/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/audit"
+	auditMocks "github.com/sangianpatrick/devoria-article-service/domain/audit/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+var location, _ = time.LoadLocation("Asia/Jakarta")
+
+func chain(count int) []audit.Entry {
+	entries := make([]audit.Entry, count)
+	prevHash := audit.GenesisHash
+	for i := range entries {
+		entries[i] = audit.Entry{
+			ID:         int64(i + 1),
+			ActorEmail: "john.doe@email.com",
+			Action:     audit.ActionArticleEdit,
+			TargetType: audit.TargetArticle,
+			TargetID:   3,
+			Diff:       map[string]audit.Change{"title": {Before: "Old", After: "New"}},
+			CreatedAt:  time.Date(2021, 8, 1, 10, 0, i, 0, location),
+			PrevHash:   prevHash,
+		}
+		entries[i].Hash = entries[i].ComputeHash()
+		prevHash = entries[i].Hash
+	}
+
+	return entries
+}
+
+func verification(t *testing.T, resp response.Response) (result audit.Verification) {
+	rec := httptest.NewRecorder()
+	resp.JSON(rec)
+
+	var body struct {
+		Data audit.Verification `json:"data"`
+	}
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+
+	return body.Data
+}
+
+// transactor runs the unit of work as it is, the repositories it would share the transaction with are mocked.
+func transactor() *databaseMocks.Transactor {
+	transactor := new(databaseMocks.Transactor)
+	transactor.On("Within", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	return transactor
+}
+
+func adminContext(accountRepository *accountMocks.AccountRepository) context.Context {
+	accountRepository.On("FindByEmail", mock.Anything, "admin@email.com").Return(entity.Account{ID: 9, Role: entity.AccountRoleAdmin}, nil)
+	return context.WithValue(context.TODO(), entity.EmailCtx, "admin@email.com")
+}
+
+func TestUsecaseVerify_Valid(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	ctx := adminContext(accountRepository)
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("FindAfter", mock.Anything, int64(0), 500).Return(chain(3), nil)
+
+	resp := audit.NewAuditUsecase(repository, accountRepository).Verify(ctx)
+
+	result := verification(t, resp)
+	assert.True(t, result.Valid)
+	assert.Equal(t, 3, result.Checked)
+	assert.Nil(t, result.BrokenAt)
+}
+
+func TestUsecaseVerify_Tampered(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	ctx := adminContext(accountRepository)
+
+	entries := chain(3)
+	entries[1].Diff["title"] = audit.Change{Before: "Old", After: "Quietly changed"}
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("FindAfter", mock.Anything, int64(0), 500).Return(entries, nil)
+
+	resp := audit.NewAuditUsecase(repository, accountRepository).Verify(ctx)
+
+	result := verification(t, resp)
+	assert.False(t, result.Valid)
+	assert.Equal(t, int64(2), *result.BrokenAt)
+}
+
+func TestUsecaseGetAll_NotAdmin(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Role: entity.AccountRoleAuthor}, nil)
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+
+	repository := new(auditMocks.AuditRepository)
+
+	resp := audit.NewAuditUsecase(repository, accountRepository).GetAll(ctx, audit.ListAuditLogRequest{})
+
+	assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	repository.AssertNotCalled(t, "FindMany", mock.Anything, mock.Anything)
+}
+
+func TestAuditedArticleUsecaseEdit_RecordsDiff(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com"}, nil)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(3)).Return(article.Article{ID: 3, Title: "Old", Author: entity.Account{ID: 1}}, nil).Once()
+	articleRepository.On("FindByID", mock.Anything, int64(3)).Return(article.Article{ID: 3, Title: "New", Author: entity.Account{ID: 1}}, nil).Once()
+
+	params := article.EditArticleRequest{ID: 3, Title: "New"}
+	usecase := new(articleMocks.ArticleUsecase)
+	usecase.On("Edit", mock.Anything, params).Return(response.Success(response.StatusOK, params))
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("Append", mock.Anything, mock.MatchedBy(func(entry audit.Entry) bool {
+		return entry.Action == audit.ActionArticleEdit &&
+			*entry.ActorID == 1 &&
+			entry.TargetID == 3 &&
+			entry.IP == "10.0.0.1" &&
+			entry.RequestID == "req-1" &&
+			len(entry.Diff) == 1 &&
+			entry.Diff["title"].Before == "Old" &&
+			entry.Diff["title"].After == "New"
+	})).Return(audit.Entry{}, nil)
+
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+	ctx = middleware.WithRequestInfo(ctx, middleware.RequestInfo{IP: "10.0.0.1", RequestID: "req-1"})
+
+	audited := audit.NewAuditedArticleUsecase(usecase, audit.NewRecorder(location, transactor(), repository, accountRepository), articleRepository)
+	resp := audited.Edit(ctx, params)
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
+
+func TestAuditedArticleUsecaseEdit_FailureIsNotRecorded(t *testing.T) {
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(3)).Return(article.Article{ID: 3}, nil).Once()
+
+	params := article.EditArticleRequest{ID: 3}
+	usecase := new(articleMocks.ArticleUsecase)
+	usecase.On("Edit", mock.Anything, params).Return(response.Error(response.StatusForbiddend, nil, exception.ErrBadRequest))
+
+	repository := new(auditMocks.AuditRepository)
+
+	audited := audit.NewAuditedArticleUsecase(usecase, audit.NewRecorder(location, transactor(), repository, new(accountMocks.AccountRepository)), articleRepository)
+	resp := audited.Edit(context.TODO(), params)
+
+	assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	repository.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestAuditedArticleUsecaseEdit_NotRecordedIsRolledBack(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com"}, nil)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(3)).Return(article.Article{ID: 3, Title: "Old"}, nil).Once()
+	articleRepository.On("FindByID", mock.Anything, int64(3)).Return(article.Article{ID: 3, Title: "New"}, nil).Once()
+
+	params := article.EditArticleRequest{ID: 3, Title: "New"}
+	usecase := new(articleMocks.ArticleUsecase)
+	usecase.On("Edit", mock.Anything, params).Return(response.Success(response.StatusOK, params))
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("Append", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(audit.Entry{}, exception.ErrInternalServer)
+
+	var rolledBack bool
+	transactor := new(databaseMocks.Transactor)
+	transactor.On("Within", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		err := fn(ctx)
+		rolledBack = err != nil
+		return err
+	})
+
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+
+	audited := audit.NewAuditedArticleUsecase(usecase, audit.NewRecorder(location, transactor, repository, accountRepository), articleRepository)
+	resp := audited.Edit(ctx, params)
+
+	assert.Equal(t, exception.ErrInternalServer, resp.Err())
+	assert.True(t, rolledBack)
+}
+
+func TestAuditedReviewUsecaseApprove_RecordsDecision(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "editor@email.com").Return(entity.Account{ID: 5, Email: "editor@email.com"}, nil)
+
+	reviewerID := int64(5)
+	reviewRepository := new(articleMocks.ReviewRepository)
+	reviewRepository.On("FindByID", mock.Anything, int64(4)).Return(article.ArticleReview{ID: 4, ReviewerID: &reviewerID, Status: article.ReviewStatusClaimed}, nil).Once()
+	reviewRepository.On("FindByID", mock.Anything, int64(4)).Return(article.ArticleReview{ID: 4, ReviewerID: &reviewerID, Status: article.ReviewStatusApproved}, nil).Once()
+
+	params := article.DecideReviewRequest{ID: 4, Comment: "Good to go"}
+	usecase := new(articleMocks.ReviewUsecase)
+	usecase.On("Approve", mock.Anything, params).Return(response.Success(response.StatusOK, article.ArticleReview{ID: 4}))
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("Append", mock.Anything, mock.MatchedBy(func(entry audit.Entry) bool {
+		return entry.Action == audit.ActionReviewApprove &&
+			*entry.ActorID == 5 &&
+			entry.TargetType == audit.TargetReview &&
+			entry.TargetID == 4 &&
+			len(entry.Diff) == 2 &&
+			entry.Diff["status"].After == string(article.ReviewStatusApproved) &&
+			entry.Diff["comment"].After == "Good to go"
+	})).Return(audit.Entry{}, nil)
+
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "editor@email.com")
+
+	audited := audit.NewAuditedReviewUsecase(usecase, audit.NewRecorder(location, transactor(), repository, accountRepository), reviewRepository)
+	resp := audited.Approve(ctx, params)
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
+
+func TestAuditedAccountUsecaseLogout_RecordsAllDevices(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com"}, nil)
+
+	params := account.LogoutRequest{AllDevices: true}
+	usecase := new(accountMocks.AccountUsecase)
+	usecase.On("Logout", mock.Anything, params).Return(response.Success(response.StatusOK, nil))
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("Append", mock.Anything, mock.MatchedBy(func(entry audit.Entry) bool {
+		return entry.Action == audit.ActionAccountLogoutAll &&
+			*entry.ActorID == 1 &&
+			entry.TargetType == audit.TargetAccount &&
+			entry.TargetID == 1
+	})).Return(audit.Entry{}, nil)
+
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+
+	audited := audit.NewAuditedAccountUsecase(usecase, audit.NewRecorder(location, transactor(), repository, accountRepository))
+	resp := audited.Logout(ctx, params)
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
+
+func TestAuditedAccountUsecaseUpdateMentionPreference_RecordsDiff(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com", Mentionable: true}, nil)
+
+	mentionable := false
+	params := account.UpdateMentionPreferenceRequest{Mentionable: &mentionable}
+	usecase := new(accountMocks.AccountUsecase)
+	usecase.On("UpdateMentionPreference", mock.Anything, params).Return(response.Success(response.StatusOK, account.MentionPreferenceResponse{}))
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("Append", mock.Anything, mock.MatchedBy(func(entry audit.Entry) bool {
+		return entry.Action == audit.ActionAccountUpdateMentionPreference &&
+			*entry.ActorID == 1 &&
+			entry.TargetID == 1 &&
+			entry.Diff["mentionable"].Before == true &&
+			entry.Diff["mentionable"].After == false
+	})).Return(audit.Entry{}, nil)
+
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+
+	audited := audit.NewAuditedAccountUsecase(usecase, audit.NewRecorder(location, transactor(), repository, accountRepository))
+	resp := audited.UpdateMentionPreference(ctx, params)
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
+
+func TestAuditedAccountUsecaseUpdateHandle_RecordsDiff(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1, Email: "john.doe@email.com", Handle: "john"}, nil)
+
+	params := account.UpdateHandleRequest{Handle: "johndoe"}
+	usecase := new(accountMocks.AccountUsecase)
+	usecase.On("UpdateHandle", mock.Anything, params).Return(response.Success(response.StatusOK, account.HandleResponse{Handle: "johndoe"}))
+
+	repository := new(auditMocks.AuditRepository)
+	repository.On("Append", mock.Anything, mock.MatchedBy(func(entry audit.Entry) bool {
+		return entry.Action == audit.ActionAccountUpdateHandle &&
+			entry.Diff["handle"].Before == "john" &&
+			entry.Diff["handle"].After == "johndoe"
+	})).Return(audit.Entry{}, nil)
+
+	ctx := context.WithValue(context.TODO(), entity.EmailCtx, "john.doe@email.com")
+
+	audited := audit.NewAuditedAccountUsecase(usecase, audit.NewRecorder(location, transactor(), repository, accountRepository))
+	resp := audited.UpdateHandle(ctx, params)
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/webhook"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+// webhookState is what the audit log keeps of a webhook, never its signing secret.
+type webhookState struct {
+	URL    string       `json:"url"`
+	Events []event.Type `json:"events"`
+}
+
+type deliveryState struct {
+	DeliveryID int64                  `json:"deliveryId"`
+	Status     webhook.DeliveryStatus `json:"status"`
+	Attempts   int                    `json:"attempts"`
+}
+
+type auditedWebhookUsecase struct {
+	webhook.WebhookUsecase
+	recorder   *Recorder
+	repository webhook.WebhookRepository
+}
+
+// NewAuditedWebhookUsecase records the webhooks registered and removed and the deliveries sent again, reads pass through.
+func NewAuditedWebhookUsecase(usecase webhook.WebhookUsecase, recorder *Recorder, repository webhook.WebhookRepository) webhook.WebhookUsecase {
+	return &auditedWebhookUsecase{
+		WebhookUsecase: usecase,
+		recorder:       recorder,
+		repository:     repository,
+	}
+}
+
+func (u *auditedWebhookUsecase) Create(ctx context.Context, params webhook.CreateWebhookRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		resp = u.WebhookUsecase.Create(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		created, ok := response.Data(resp).(webhook.Webhook)
+		if !ok {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionWebhookCreate,
+			TargetType: TargetWebhook,
+			TargetID:   created.ID,
+			Diff:       diff(nil, webhookState{URL: created.URL, Events: created.Events}),
+		})
+
+		return
+	})
+}
+
+func (u *auditedWebhookUsecase) Delete(ctx context.Context, params webhook.DeleteWebhookRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		var before interface{}
+		if existing, err := u.repository.FindByID(ctx, params.ID); err == nil {
+			before = webhookState{URL: existing.URL, Events: existing.Events}
+		}
+
+		resp = u.WebhookUsecase.Delete(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionWebhookDelete,
+			TargetType: TargetWebhook,
+			TargetID:   params.ID,
+			Diff:       diff(before, nil),
+		})
+
+		return
+	})
+}
+
+func (u *auditedWebhookUsecase) Redeliver(ctx context.Context, params webhook.RedeliverRequest) (resp response.Response) {
+	return u.recorder.Within(ctx, func(ctx context.Context) (resp response.Response, err error) {
+		var before interface{}
+		if existing, err := u.repository.FindDelivery(ctx, params.DeliveryID); err == nil {
+			before = deliveryState{DeliveryID: existing.ID, Status: existing.Status, Attempts: existing.Attempts}
+		}
+
+		resp = u.WebhookUsecase.Redeliver(ctx, params)
+		if resp.Err() != nil {
+			return
+		}
+
+		delivery, ok := response.Data(resp).(webhook.Delivery)
+		if !ok {
+			return
+		}
+
+		err = u.recorder.Record(ctx, u.recorder.Actor(ctx), Entry{
+			Action:     ActionWebhookRedeliver,
+			TargetType: TargetWebhook,
+			TargetID:   params.WebhookID,
+			Diff:       diff(before, deliveryState{DeliveryID: delivery.ID, Status: delivery.Status, Attempts: delivery.Attempts}),
+		})
+
+		return
+	})
+}
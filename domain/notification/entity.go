@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// UnsubscribeAudience marks tokens that may only be used to unsubscribe.
+const UnsubscribeAudience = "notification:unsubscribe"
+
+// Preference is what an account wants to be emailed about, accounts without a row get the defaults.
+type Preference struct {
+	AccountID      int64      `json:"accountId"`
+	EmailOnPublish bool       `json:"emailOnPublish"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+// DefaultPreference is the preference of an account that never changed it.
+func DefaultPreference(accountID int64) Preference {
+	return Preference{
+		AccountID:      accountID,
+		EmailOnPublish: true,
+	}
+}
+
+// EmailStatus is a type of queued email current status.
+type EmailStatus string
+
+const (
+	EmailStatusPending EmailStatus = "PENDING"
+	EmailStatusSent    EmailStatus = "SENT"
+	EmailStatusFailed  EmailStatus = "FAILED"
+)
+
+// Email is a rendered email waiting in the queue.
+type Email struct {
+	ID             int64
+	EventID        int64
+	ArticleID      int64
+	AccountID      int64
+	Topic          UnsubscribeTopic
+	To             string
+	Subject        string
+	HTML           string
+	Text           string
+	UnsubscribeURL string
+	Status         EmailStatus
+	Attempts       int
+	LastError      string
+	CreatedAt      time.Time
+	SentAt         *time.Time
+}
+
+// UnsubscribeTopic tells which emails an unsubscribe token opts out of.
+type UnsubscribeTopic string
+
+const (
+	// UnsubscribeTopicPublish is empty so the tokens issued before there were topics keep working.
+	UnsubscribeTopicPublish  UnsubscribeTopic = ""
+	UnsubscribeTopicMentions UnsubscribeTopic = "mentions"
+)
+
+// UnsubscribeClaims is a model of unsubscribe token claims.
+// They never expire so links in old emails keep working.
+type UnsubscribeClaims struct {
+	jwt.StandardClaims
+	AccountID int64            `json:"accountId"`
+	Topic     UnsubscribeTopic `json:"topic,omitempty"`
+}
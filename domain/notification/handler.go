@@ -0,0 +1,166 @@
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type NotificationHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  NotificationUsecase
+}
+
+func NewNotificationHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase NotificationUsecase,
+) {
+	handler := &NotificationHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/accounts/notification-preferences", bearerAuthMiddleware.VerifyBearer(handler.GetPreference)).Methods(http.MethodGet)
+	router.HandleFunc("/v1/notifications/unsubscribe", handler.ConfirmUnsubscribe).Methods(http.MethodGet)
+	//Post
+	router.HandleFunc("/v1/accounts/{id:[0-9]+}/follow", bearerAuthMiddleware.VerifyBearer(handler.Follow)).Methods(http.MethodPost)
+	router.HandleFunc("/v1/notifications/unsubscribe", handler.Unsubscribe).Methods(http.MethodPost)
+	//Put
+	router.HandleFunc("/v1/accounts/notification-preferences", bearerAuthMiddleware.VerifyBearer(handler.UpdatePreference)).Methods(http.MethodPut)
+	//Delete
+	router.HandleFunc("/v1/accounts/{id:[0-9]+}/follow", bearerAuthMiddleware.VerifyBearer(handler.Unfollow)).Methods(http.MethodDelete)
+}
+
+func (handler *NotificationHTTPHandler) followRequest(r *http.Request) (params FollowRequest, resp response.Response) {
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return params, response.Error(response.StatusUnprocessabelEntity, nil, err)
+	}
+
+	params.AuthorID = convertedID
+
+	err = handler.Validate.StructCtx(r.Context(), params)
+	if err != nil {
+		return params, response.Error(response.StatusInvalidPayload, nil, err)
+	}
+
+	return params, nil
+}
+
+func (handler *NotificationHTTPHandler) Follow(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
+
+	params, resp := handler.followRequest(r)
+	if resp != nil {
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Follow(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *NotificationHTTPHandler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	var ctx = r.Context()
+
+	params, resp := handler.followRequest(r)
+	if resp != nil {
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Unfollow(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *NotificationHTTPHandler) GetPreference(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var ctx = r.Context()
+
+	resp = handler.Usecase.GetPreference(ctx)
+	resp.JSON(w)
+}
+
+func (handler *NotificationHTTPHandler) UpdatePreference(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UpdatePreferenceRequest
+	var ctx = r.Context()
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.UpdatePreference(ctx, params)
+	resp.JSON(w)
+}
+
+// ConfirmUnsubscribe serves the link in the email. It changes nothing, the page posts back to Unsubscribe.
+func (handler *NotificationHTTPHandler) ConfirmUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UnsubscribeRequest
+	var ctx = r.Context()
+
+	params.Token = r.URL.Query().Get("token")
+
+	err := handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	html, err := renderUnsubscribePage(unsubscribePage{Action: r.URL.Path + "?" + url.Values{"token": {params.Token}}.Encode()})
+	if err != nil {
+		resp = response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		resp.JSON(w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", middleware.CacheControlNoStore)
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(html))
+}
+
+// Unsubscribe takes the POST of the confirmation page and the RFC 8058 one-click POST mail clients send for List-Unsubscribe.
+func (handler *NotificationHTTPHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params UnsubscribeRequest
+	var ctx = r.Context()
+
+	params.Token = r.URL.Query().Get("token")
+
+	err := handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Unsubscribe(ctx, params)
+	resp.JSON(w)
+}
@@ -0,0 +1,45 @@
+package notification_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/notification"
+	notificationMocks "github.com/sangianpatrick/devoria-article-service/domain/notification/mocks"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+func TestHandlerConfirmUnsubscribe_ChangesNothing(t *testing.T) {
+	usecase := new(notificationMocks.NotificationUsecase)
+	handler := notification.NotificationHTTPHandler{Validate: validator.New(), Usecase: usecase}
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/notifications/unsubscribe?token=a.b%22c", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.ConfirmUnsubscribe(recorder, r)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, recorder.Body.String(), `<form method="post" action="/v1/notifications/unsubscribe?token=a.b%22c">`)
+	usecase.AssertNotCalled(t, "Unsubscribe", mock.Anything, mock.Anything)
+}
+
+func TestHandlerUnsubscribe_OneClickPost(t *testing.T) {
+	usecase := new(notificationMocks.NotificationUsecase)
+	usecase.On("Unsubscribe", mock.Anything, notification.UnsubscribeRequest{Token: "a.b.c"}).Return(response.Success(response.StatusOK, nil))
+	handler := notification.NotificationHTTPHandler{Validate: validator.New(), Usecase: usecase}
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/notifications/unsubscribe?token=a.b.c", strings.NewReader("List-Unsubscribe=One-Click"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	recorder := httptest.NewRecorder()
+
+	handler.Unsubscribe(recorder, r)
+
+	usecase.AssertExpectations(t)
+}
@@ -0,0 +1,291 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	jsonWebToken "github.com/sangianpatrick/devoria-article-service/jwt"
+	"github.com/sangianpatrick/devoria-article-service/mailer"
+)
+
+// maxErrorLength keeps the email log readable when the mail server answers with a long error.
+const maxErrorLength = 500
+
+// Notifier queues the emails of published articles for the author's followers and the mentioned accounts, and sends them.
+type Notifier struct {
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	baseURL     string
+	location    *time.Location
+	jwt         jsonWebToken.JSONWebToken
+	mailer      mailer.Mailer
+	repository  NotificationRepository
+	articleRepo article.ArticleRepository
+	accountRepo account.AccountRepository
+}
+
+func NewNotifier(
+	interval time.Duration,
+	maxAttempts int,
+	baseURL string,
+	location *time.Location,
+	jwt jsonWebToken.JSONWebToken,
+	mailer mailer.Mailer,
+	repository NotificationRepository,
+	articleRepo article.ArticleRepository,
+	accountRepo account.AccountRepository,
+) *Notifier {
+	return &Notifier{
+		interval:    interval,
+		batchSize:   50,
+		maxAttempts: maxAttempts,
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		location:    location,
+		jwt:         jwt,
+		mailer:      mailer,
+		repository:  repository,
+		articleRepo: articleRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+// Subscribe makes the notifier queue emails for every article published on the bus.
+func (n *Notifier) Subscribe(publisher *event.InMemoryPublisher) {
+	publisher.Subscribe(event.ArticlePublished, n.OnArticlePublished)
+}
+
+// OnArticlePublished renders and queues one email per mentioned account and per follower who did not opt out.
+// A follower who is also mentioned only gets the mention email. Followers are only told about the first release,
+// an article that was unlisted or archived and is published again is not news to them. Mentioned accounts are
+// only emailed once per article, so a release only reaches the handles added since the previous one.
+func (n *Notifier) OnArticlePublished(ctx context.Context, evt event.Event) (err error) {
+	var payload article.ArticleEvent
+	if err = json.Unmarshal(evt.Payload, &payload); err != nil {
+		log.Println(err)
+		return nil
+	}
+
+	publishedArticle, err := n.articleRepo.FindByID(ctx, payload.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return nil
+		}
+		return
+	}
+
+	var mentioned []entity.Account
+	if handles := article.Mentions(publishedArticle.Content); len(handles) > 0 {
+		mentioned, err = n.accountRepo.FindByHandles(ctx, handles)
+		if err != nil {
+			return
+		}
+	}
+
+	var followers []entity.Account
+	if payload.PreviousStatus == "" || payload.PreviousStatus.IsUnreleased() {
+		followers, err = n.repository.FindSubscribedFollowers(ctx, payload.AuthorID)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(mentioned) == 0 && len(followers) == 0 {
+		return
+	}
+
+	author, err := n.accountRepo.FindByID(ctx, payload.AuthorID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return nil
+		}
+		return
+	}
+
+	now := time.Now().In(n.location)
+	authorName := strings.TrimSpace(fmt.Sprintf("%s %s", author.FirstName, author.LastName))
+	articleURL := fmt.Sprintf("%s/v1/article/%d", n.baseURL, publishedArticle.ID)
+	emails := make([]Email, 0, len(mentioned)+len(followers))
+	notified := map[int64]bool{author.ID: true}
+
+	for _, account := range mentioned {
+		if notified[account.ID] {
+			continue
+		}
+		notified[account.ID] = true
+
+		unsubscribeURL, err := n.unsubscribeURL(ctx, account.ID, UnsubscribeTopicMentions, now)
+		if err != nil {
+			return err
+		}
+
+		data := mentionedEmail{
+			RecipientName:  account.FirstName,
+			AuthorName:     authorName,
+			Title:          publishedArticle.Title,
+			ArticleURL:     articleURL,
+			UnsubscribeURL: unsubscribeURL,
+		}
+
+		html, text, err := renderMentioned(data)
+		if err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+
+		emails = append(emails, Email{
+			EventID:        evt.ID,
+			ArticleID:      publishedArticle.ID,
+			AccountID:      account.ID,
+			Topic:          UnsubscribeTopicMentions,
+			To:             account.Email,
+			Subject:        fmt.Sprintf("%s mentioned you in: %s", authorName, publishedArticle.Title),
+			HTML:           html,
+			Text:           text,
+			UnsubscribeURL: unsubscribeURL,
+			Status:         EmailStatusPending,
+			CreatedAt:      now,
+		})
+	}
+
+	for _, follower := range followers {
+		if notified[follower.ID] {
+			continue
+		}
+
+		unsubscribeURL, err := n.unsubscribeURL(ctx, follower.ID, UnsubscribeTopicPublish, now)
+		if err != nil {
+			return err
+		}
+
+		data := publishedEmail{
+			RecipientName:  follower.FirstName,
+			AuthorName:     authorName,
+			Title:          publishedArticle.Title,
+			Subtitle:       publishedArticle.Subtitle,
+			ArticleURL:     articleURL,
+			UnsubscribeURL: unsubscribeURL,
+		}
+
+		html, text, err := renderPublished(data)
+		if err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+
+		emails = append(emails, Email{
+			EventID:        evt.ID,
+			ArticleID:      publishedArticle.ID,
+			AccountID:      follower.ID,
+			Topic:          UnsubscribeTopicPublish,
+			To:             follower.Email,
+			Subject:        fmt.Sprintf("%s published: %s", authorName, publishedArticle.Title),
+			HTML:           html,
+			Text:           text,
+			UnsubscribeURL: unsubscribeURL,
+			Status:         EmailStatusPending,
+			CreatedAt:      now,
+		})
+	}
+
+	if len(emails) == 0 {
+		return
+	}
+
+	return n.repository.QueueEmails(ctx, emails)
+}
+
+func (n *Notifier) unsubscribeURL(ctx context.Context, accountID int64, topic UnsubscribeTopic, at time.Time) (unsubscribeURL string, err error) {
+	claims := UnsubscribeClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience: UnsubscribeAudience,
+			Subject:  fmt.Sprintf("%d", accountID),
+			IssuedAt: at.Unix(),
+		},
+		AccountID: accountID,
+		Topic:     topic,
+	}
+
+	token, err := n.jwt.Sign(ctx, claims)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return fmt.Sprintf("%s/v1/notifications/unsubscribe?token=%s", n.baseURL, url.QueryEscape(token)), nil
+}
+
+// Run sends the queued emails on every tick until the context is cancelled.
+func (n *Notifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := n.SendPending(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// SendPending sends a batch of queued emails, an email that keeps failing is given up after the max attempts.
+func (n *Notifier) SendPending(ctx context.Context) (sent int, err error) {
+	emails, err := n.repository.FindPendingEmails(ctx, n.batchSize)
+	if err != nil {
+		return
+	}
+
+	for _, email := range emails {
+		message := mailer.Message{
+			To:      email.To,
+			Subject: email.Subject,
+			HTML:    email.HTML,
+			Text:    email.Text,
+			Headers: map[string]string{
+				"List-Unsubscribe":      fmt.Sprintf("<%s>", email.UnsubscribeURL),
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		}
+
+		email.Attempts++
+		if sendErr := n.mailer.Send(ctx, message); sendErr != nil {
+			log.Println(sendErr)
+			email.LastError = sendErr.Error()
+			if len(email.LastError) > maxErrorLength {
+				email.LastError = email.LastError[:maxErrorLength]
+			}
+			if email.Attempts >= n.maxAttempts {
+				email.Status = EmailStatusFailed
+			}
+		} else {
+			sentAt := time.Now().In(n.location)
+			email.Status = EmailStatusSent
+			email.LastError = ""
+			email.SentAt = &sentAt
+			sent++
+		}
+
+		if err = n.repository.UpdateEmail(ctx, email); err != nil {
+			return
+		}
+	}
+
+	return
+}
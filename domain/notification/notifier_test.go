@@ -0,0 +1,214 @@
+package notification_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	articleMocks "github.com/sangianpatrick/devoria-article-service/domain/article/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/notification"
+	notificationMocks "github.com/sangianpatrick/devoria-article-service/domain/notification/mocks"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	jwtMocks "github.com/sangianpatrick/devoria-article-service/jwt/mocks"
+	"github.com/sangianpatrick/devoria-article-service/mailer"
+	mailerMocks "github.com/sangianpatrick/devoria-article-service/mailer/mocks"
+)
+
+var location, _ = time.LoadLocation("Asia/Jakarta")
+
+func TestNotifierOnArticlePublished_QueuesForFollowers(t *testing.T) {
+	evt, _ := event.New(event.ArticlePublished, 7, article.ArticleEvent{ID: 7, AuthorID: 1, Status: article.ArticleStatusPublished}, time.Now())
+	evt.ID = 42
+
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("FindSubscribedFollowers", mock.Anything, int64(1)).Return([]entity.Account{
+		{ID: 2, Email: "jane.doe@email.com", FirstName: "Jane"},
+	}, nil)
+	repository.On("QueueEmails", mock.Anything, mock.MatchedBy(func(emails []notification.Email) bool {
+		return len(emails) == 1 &&
+			emails[0].EventID == 42 &&
+			emails[0].To == "jane.doe@email.com" &&
+			emails[0].Status == notification.EmailStatusPending &&
+			emails[0].UnsubscribeURL == "https://devoria.id/v1/notifications/unsubscribe?token=tok" &&
+			strings.Contains(emails[0].HTML, `<a href="https://devoria.id/v1/article/7">Fish &amp; Chips</a>`) &&
+			strings.Contains(emails[0].Text, "Read it at https://devoria.id/v1/article/7")
+	})).Return(nil)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(7)).Return(article.Article{ID: 7, Title: "Fish & Chips"}, nil)
+
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByID", mock.Anything, int64(1)).Return(entity.Account{ID: 1, FirstName: "John", LastName: "Doe"}, nil)
+
+	jsonWebToken := new(jwtMocks.JSONWebToken)
+	jsonWebToken.On("Sign", mock.Anything, mock.MatchedBy(func(claims notification.UnsubscribeClaims) bool {
+		return claims.AccountID == 2 && claims.Audience == notification.UnsubscribeAudience
+	})).Return("tok", nil)
+
+	notifier := notification.NewNotifier(time.Second, 3, "https://devoria.id/", location, jsonWebToken, new(mailerMocks.Mailer), repository, articleRepository, accountRepository)
+
+	err := notifier.OnArticlePublished(context.TODO(), evt)
+
+	assert.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestNotifierOnArticlePublished_NoFollowers(t *testing.T) {
+	evt, _ := event.New(event.ArticlePublished, 7, article.ArticleEvent{ID: 7, AuthorID: 1}, time.Now())
+
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("FindSubscribedFollowers", mock.Anything, int64(1)).Return(nil, nil)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(7)).Return(article.Article{ID: 7, Content: "no one to tell"}, nil)
+
+	accountRepository := new(accountMocks.AccountRepository)
+
+	notifier := notification.NewNotifier(time.Second, 3, "https://devoria.id", location, new(jwtMocks.JSONWebToken), new(mailerMocks.Mailer), repository, articleRepository, accountRepository)
+
+	err := notifier.OnArticlePublished(context.TODO(), evt)
+
+	assert.NoError(t, err)
+	accountRepository.AssertNotCalled(t, "FindByHandles", mock.Anything, mock.Anything)
+	repository.AssertNotCalled(t, "QueueEmails", mock.Anything, mock.Anything)
+}
+
+func TestNotifierOnArticlePublished_QueuesForMentioned(t *testing.T) {
+	evt, _ := event.New(event.ArticlePublished, 7, article.ArticleEvent{ID: 7, AuthorID: 1, Status: article.ArticleStatusPublished}, time.Now())
+	evt.ID = 42
+
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("FindSubscribedFollowers", mock.Anything, int64(1)).Return([]entity.Account{
+		{ID: 2, Email: "jane.doe@email.com", FirstName: "Jane"},
+		{ID: 3, Email: "jim.doe@email.com", FirstName: "Jim"},
+	}, nil)
+	repository.On("QueueEmails", mock.Anything, mock.MatchedBy(func(emails []notification.Email) bool {
+		return len(emails) == 2 &&
+			emails[0].AccountID == 2 &&
+			emails[0].ArticleID == 7 &&
+			emails[0].Topic == notification.UnsubscribeTopicMentions &&
+			emails[0].Subject == "John Doe mentioned you in: Thanks" &&
+			emails[0].UnsubscribeURL == "https://devoria.id/v1/notifications/unsubscribe?token=mentions" &&
+			emails[1].AccountID == 3 &&
+			emails[1].ArticleID == 7 &&
+			emails[1].Topic == notification.UnsubscribeTopicPublish &&
+			emails[1].Subject == "John Doe published: Thanks" &&
+			emails[1].UnsubscribeURL == "https://devoria.id/v1/notifications/unsubscribe?token=publish"
+	})).Return(nil)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(7)).Return(article.Article{ID: 7, Title: "Thanks", Content: "Thanks [@jane](https://devoria.id/v1/accounts/2) and @johndoe"}, nil)
+
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByHandles", mock.Anything, []string{"johndoe", "jane"}).Return([]entity.Account{
+		{ID: 1, Email: "john.doe@email.com", FirstName: "John", Handle: "johndoe"},
+		{ID: 2, Email: "jane.doe@email.com", FirstName: "Jane", Handle: "jane"},
+	}, nil)
+	accountRepository.On("FindByID", mock.Anything, int64(1)).Return(entity.Account{ID: 1, FirstName: "John", LastName: "Doe"}, nil)
+
+	jsonWebToken := new(jwtMocks.JSONWebToken)
+	jsonWebToken.On("Sign", mock.Anything, mock.MatchedBy(func(claims notification.UnsubscribeClaims) bool {
+		return claims.Topic == notification.UnsubscribeTopicMentions
+	})).Return("mentions", nil)
+	jsonWebToken.On("Sign", mock.Anything, mock.MatchedBy(func(claims notification.UnsubscribeClaims) bool {
+		return claims.Topic == notification.UnsubscribeTopicPublish
+	})).Return("publish", nil)
+
+	notifier := notification.NewNotifier(time.Second, 3, "https://devoria.id", location, jsonWebToken, new(mailerMocks.Mailer), repository, articleRepository, accountRepository)
+
+	err := notifier.OnArticlePublished(context.TODO(), evt)
+
+	assert.NoError(t, err)
+	repository.AssertExpectations(t)
+	accountRepository.AssertExpectations(t)
+}
+
+func TestNotifierSendPending(t *testing.T) {
+	pending := []notification.Email{
+		{ID: 1, To: "jane.doe@email.com", Subject: "Hi", UnsubscribeURL: "https://devoria.id/u", Status: notification.EmailStatusPending},
+		{ID: 2, To: "bounce@email.com", Subject: "Hi", Status: notification.EmailStatusPending, Attempts: 2},
+	}
+
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("FindPendingEmails", mock.Anything, 50).Return(pending, nil)
+	repository.On("UpdateEmail", mock.Anything, mock.MatchedBy(func(email notification.Email) bool {
+		return email.ID == 1 && email.Status == notification.EmailStatusSent && email.Attempts == 1 && email.SentAt != nil
+	})).Return(nil).Once()
+	repository.On("UpdateEmail", mock.Anything, mock.MatchedBy(func(email notification.Email) bool {
+		return email.ID == 2 && email.Status == notification.EmailStatusFailed && email.Attempts == 3 && email.LastError != ""
+	})).Return(nil).Once()
+
+	mail := new(mailerMocks.Mailer)
+	mail.On("Send", mock.Anything, mock.MatchedBy(func(message mailer.Message) bool {
+		return message.To == "jane.doe@email.com" && message.Headers["List-Unsubscribe"] == "<https://devoria.id/u>"
+	})).Return(nil)
+	mail.On("Send", mock.Anything, mock.MatchedBy(func(message mailer.Message) bool {
+		return message.To == "bounce@email.com"
+	})).Return(fmt.Errorf("550 mailbox unavailable"))
+
+	notifier := notification.NewNotifier(time.Second, 3, "https://devoria.id", location, new(jwtMocks.JSONWebToken), mail, repository, new(articleMocks.ArticleRepository), new(accountMocks.AccountRepository))
+
+	sent, err := notifier.SendPending(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	repository.AssertExpectations(t)
+}
+
+func TestNotifierOnArticlePublished_RepublishedIsNotNews(t *testing.T) {
+	evt, _ := event.New(event.ArticlePublished, 7, article.ArticleEvent{ID: 7, AuthorID: 1, Status: article.ArticleStatusPublished, PreviousStatus: article.ArticleStatusUnlisted}, time.Now())
+
+	repository := new(notificationMocks.NotificationRepository)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(7)).Return(article.Article{ID: 7, Title: "Fish & Chips"}, nil)
+
+	notifier := notification.NewNotifier(time.Second, 3, "https://devoria.id", location, new(jwtMocks.JSONWebToken), new(mailerMocks.Mailer), repository, articleRepository, new(accountMocks.AccountRepository))
+
+	err := notifier.OnArticlePublished(context.TODO(), evt)
+
+	assert.NoError(t, err)
+	repository.AssertNotCalled(t, "FindSubscribedFollowers", mock.Anything, mock.Anything)
+	repository.AssertNotCalled(t, "QueueEmails", mock.Anything, mock.Anything)
+}
+
+func TestNotifierOnArticlePublished_RepublishedQueuesMentionsOnly(t *testing.T) {
+	evt, _ := event.New(event.ArticlePublished, 7, article.ArticleEvent{ID: 7, AuthorID: 1, Status: article.ArticleStatusPublished, PreviousStatus: article.ArticleStatusArchived}, time.Now())
+
+	//Accounts mentioned in an earlier release are left out by the queue
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("QueueEmails", mock.Anything, mock.MatchedBy(func(emails []notification.Email) bool {
+		return len(emails) == 1 &&
+			emails[0].AccountID == 2 &&
+			emails[0].ArticleID == 7 &&
+			emails[0].Topic == notification.UnsubscribeTopicMentions
+	})).Return(nil)
+
+	articleRepository := new(articleMocks.ArticleRepository)
+	articleRepository.On("FindByID", mock.Anything, int64(7)).Return(article.Article{ID: 7, Title: "Thanks", Content: "Thanks @jane"}, nil)
+
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByHandles", mock.Anything, []string{"jane"}).Return([]entity.Account{
+		{ID: 2, Email: "jane.doe@email.com", FirstName: "Jane", Handle: "jane"},
+	}, nil)
+	accountRepository.On("FindByID", mock.Anything, int64(1)).Return(entity.Account{ID: 1, FirstName: "John", LastName: "Doe"}, nil)
+
+	jsonWebToken := new(jwtMocks.JSONWebToken)
+	jsonWebToken.On("Sign", mock.Anything, mock.AnythingOfType("notification.UnsubscribeClaims")).Return("mentions", nil)
+
+	notifier := notification.NewNotifier(time.Second, 3, "https://devoria.id", location, jsonWebToken, new(mailerMocks.Mailer), repository, articleRepository, accountRepository)
+
+	err := notifier.OnArticlePublished(context.TODO(), evt)
+
+	assert.NoError(t, err)
+	repository.AssertExpectations(t)
+	repository.AssertNotCalled(t, "FindSubscribedFollowers", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,297 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type NotificationRepository interface {
+	Follow(ctx context.Context, followerID int64, authorID int64, at time.Time) (err error)
+	Unfollow(ctx context.Context, followerID int64, authorID int64) (err error)
+	FindSubscribedFollowers(ctx context.Context, authorID int64) (followers []entity.Account, err error)
+	FindPreference(ctx context.Context, accountID int64) (preference Preference, err error)
+	SavePreference(ctx context.Context, preference Preference) (err error)
+	QueueEmails(ctx context.Context, emails []Email) (err error)
+	FindPendingEmails(ctx context.Context, limit int) (emails []Email, err error)
+	UpdateEmail(ctx context.Context, email Email) (err error)
+}
+
+type notificationRepositoryImpl struct {
+	db                  *sql.DB
+	followerTableName   string
+	preferenceTableName string
+	emailTableName      string
+	accountTableName    string
+}
+
+func NewNotificationRepository(db *sql.DB, followerTableName, preferenceTableName, emailTableName, accountTableName string) NotificationRepository {
+	return &notificationRepositoryImpl{
+		db:                  db,
+		followerTableName:   followerTableName,
+		preferenceTableName: preferenceTableName,
+		emailTableName:      emailTableName,
+		accountTableName:    accountTableName,
+	}
+}
+
+func (r *notificationRepositoryImpl) Follow(ctx context.Context, followerID int64, authorID int64, at time.Time) (err error) {
+	command := fmt.Sprintf(`INSERT IGNORE INTO %s (followerId, authorId, createdAt) VALUES (?, ?, ?)`, r.followerTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, followerID, authorID, at)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (r *notificationRepositoryImpl) Unfollow(ctx context.Context, followerID int64, authorID int64) (err error) {
+	command := fmt.Sprintf(`DELETE FROM %s WHERE followerId = ? AND authorId = ?`, r.followerTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, followerID, authorID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+// FindSubscribedFollowers returns the followers of the author who still want an email when they publish.
+func (r *notificationRepositoryImpl) FindSubscribedFollowers(ctx context.Context, authorID int64) (followers []entity.Account, err error) {
+	query := fmt.Sprintf(
+		`SELECT a.id, a.email, a.firstName, a.lastName FROM %s f JOIN %s a ON a.id = f.followerId LEFT JOIN %s p ON p.accountId = f.followerId WHERE f.authorId = ? AND COALESCE(p.emailOnPublish, 1) = 1`,
+		r.followerTableName,
+		r.accountTableName,
+		r.preferenceTableName,
+	)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, authorID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		follower := entity.Account{}
+
+		err = rows.Scan(
+			&follower.ID,
+			&follower.Email,
+			&follower.FirstName,
+			&follower.LastName,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		followers = append(followers, follower)
+	}
+
+	return
+}
+
+func (r *notificationRepositoryImpl) FindPreference(ctx context.Context, accountID int64) (preference Preference, err error) {
+	query := fmt.Sprintf(`SELECT accountId, emailOnPublish, updatedAt FROM %s WHERE accountId = ?`, r.preferenceTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	var updatedAt sql.NullTime
+
+	err = stmt.QueryRowContext(ctx, accountID).Scan(
+		&preference.AccountID,
+		&preference.EmailOnPublish,
+		&updatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return DefaultPreference(accountID), nil
+	}
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	if updatedAt.Valid {
+		preference.UpdatedAt = &updatedAt.Time
+	}
+
+	return
+}
+
+func (r *notificationRepositoryImpl) SavePreference(ctx context.Context, preference Preference) (err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (accountId, emailOnPublish, updatedAt) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE emailOnPublish = VALUES(emailOnPublish), updatedAt = VALUES(updatedAt)`, r.preferenceTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, preference.AccountID, preference.EmailOnPublish, preference.UpdatedAt)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+// QueueEmails ignores emails already queued for the same article, account and topic.
+// Events may be delivered twice and an article may be published again, an account is told about it once.
+func (r *notificationRepositoryImpl) QueueEmails(ctx context.Context, emails []Email) (err error) {
+	command := fmt.Sprintf(`INSERT IGNORE INTO %s (eventId, articleId, accountId, topic, toAddress, subject, html, text, unsubscribeUrl, status, attempts, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, r.emailTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	for _, email := range emails {
+		_, err = stmt.ExecContext(
+			ctx,
+			email.EventID,
+			email.ArticleID,
+			email.AccountID,
+			email.Topic,
+			email.To,
+			email.Subject,
+			email.HTML,
+			email.Text,
+			email.UnsubscribeURL,
+			email.Status,
+			email.Attempts,
+			email.CreatedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+	}
+
+	return
+}
+
+func (r *notificationRepositoryImpl) FindPendingEmails(ctx context.Context, limit int) (emails []Email, err error) {
+	query := fmt.Sprintf(`SELECT id, eventId, accountId, toAddress, subject, html, text, unsubscribeUrl, status, attempts, createdAt FROM %s WHERE status = ? ORDER BY id LIMIT ?`, r.emailTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, EmailStatusPending, limit)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		email := Email{}
+
+		err = rows.Scan(
+			&email.ID,
+			&email.EventID,
+			&email.AccountID,
+			&email.To,
+			&email.Subject,
+			&email.HTML,
+			&email.Text,
+			&email.UnsubscribeURL,
+			&email.Status,
+			&email.Attempts,
+			&email.CreatedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		emails = append(emails, email)
+	}
+
+	return
+}
+
+func (r *notificationRepositoryImpl) UpdateEmail(ctx context.Context, email Email) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET status = ?, attempts = ?, lastError = ?, sentAt = ? WHERE id = ?`, r.emailTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, email.Status, email.Attempts, email.LastError, email.SentAt, email.ID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
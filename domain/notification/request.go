@@ -0,0 +1,16 @@
+package notification
+
+// FollowRequest is model for following or unfollowing an author.
+type FollowRequest struct {
+	AuthorID int64 `json:"authorId" validate:"required"`
+}
+
+// UpdatePreferenceRequest is model for changing the notification preferences.
+type UpdatePreferenceRequest struct {
+	EmailOnPublish *bool `json:"emailOnPublish" validate:"required"`
+}
+
+// UnsubscribeRequest is model for the unsubscribe link in the emails.
+type UnsubscribeRequest struct {
+	Token string `json:"token" validate:"required"`
+}
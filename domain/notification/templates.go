@@ -0,0 +1,132 @@
+package notification
+
+import (
+	"bytes"
+	htmlTemplate "html/template"
+	textTemplate "text/template"
+)
+
+// publishedEmail is what the published article templates are rendered with.
+type publishedEmail struct {
+	RecipientName  string
+	AuthorName     string
+	Title          string
+	Subtitle       string
+	ArticleURL     string
+	UnsubscribeURL string
+}
+
+var publishedHTMLTemplate = htmlTemplate.Must(htmlTemplate.New("published.html").Parse(`<!DOCTYPE html>
+<html>
+<body>
+<p>Hi {{.RecipientName}},</p>
+<p>{{.AuthorName}} just published a new article.</p>
+<h2><a href="{{.ArticleURL}}">{{.Title}}</a></h2>
+{{if .Subtitle}}<p>{{.Subtitle}}</p>
+{{end}}<p><a href="{{.ArticleURL}}">Read the article</a></p>
+<hr>
+<p><small>You receive this email because you follow {{.AuthorName}}. <a href="{{.UnsubscribeURL}}">Unsubscribe</a></small></p>
+</body>
+</html>
+`))
+
+var publishedTextTemplate = textTemplate.Must(textTemplate.New("published.txt").Parse(`Hi {{.RecipientName}},
+
+{{.AuthorName}} just published a new article.
+
+{{.Title}}
+{{if .Subtitle}}{{.Subtitle}}
+{{end}}
+Read it at {{.ArticleURL}}
+
+--
+You receive this email because you follow {{.AuthorName}}.
+Unsubscribe: {{.UnsubscribeURL}}
+`))
+
+// mentionedEmail is what the mention templates are rendered with.
+type mentionedEmail struct {
+	RecipientName  string
+	AuthorName     string
+	Title          string
+	ArticleURL     string
+	UnsubscribeURL string
+}
+
+var mentionedHTMLTemplate = htmlTemplate.Must(htmlTemplate.New("mentioned.html").Parse(`<!DOCTYPE html>
+<html>
+<body>
+<p>Hi {{.RecipientName}},</p>
+<p>{{.AuthorName}} mentioned you in a new article.</p>
+<h2><a href="{{.ArticleURL}}">{{.Title}}</a></h2>
+<p><a href="{{.ArticleURL}}">Read the article</a></p>
+<hr>
+<p><small>You receive this email because authors can mention you. <a href="{{.UnsubscribeURL}}">Stop being mentioned</a></small></p>
+</body>
+</html>
+`))
+
+var mentionedTextTemplate = textTemplate.Must(textTemplate.New("mentioned.txt").Parse(`Hi {{.RecipientName}},
+
+{{.AuthorName}} mentioned you in a new article.
+
+{{.Title}}
+
+Read it at {{.ArticleURL}}
+
+--
+You receive this email because authors can mention you.
+Stop being mentioned: {{.UnsubscribeURL}}
+`))
+
+// unsubscribePage is what the unsubscribe confirmation page is rendered with.
+type unsubscribePage struct {
+	Action string
+}
+
+// unsubscribePageTemplate only asks to confirm, link scanners and prefetchers follow the link in the email too.
+var unsubscribePageTemplate = htmlTemplate.Must(htmlTemplate.New("unsubscribe.html").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta name="robots" content="noindex">
+<title>Unsubscribe</title>
+</head>
+<body>
+<p>Do you want to stop receiving these emails?</p>
+<form method="post" action="{{.Action}}">
+<button type="submit">Unsubscribe</button>
+</form>
+</body>
+</html>
+`))
+
+func renderUnsubscribePage(data unsubscribePage) (html string, err error) {
+	var htmlBuffer bytes.Buffer
+
+	if err = unsubscribePageTemplate.Execute(&htmlBuffer, data); err != nil {
+		return
+	}
+
+	return htmlBuffer.String(), nil
+}
+
+func renderPublished(data publishedEmail) (html string, text string, err error) {
+	return render(publishedHTMLTemplate, publishedTextTemplate, data)
+}
+
+func renderMentioned(data mentionedEmail) (html string, text string, err error) {
+	return render(mentionedHTMLTemplate, mentionedTextTemplate, data)
+}
+
+func render(html *htmlTemplate.Template, text *textTemplate.Template, data interface{}) (renderedHTML string, renderedText string, err error) {
+	var htmlBuffer, textBuffer bytes.Buffer
+
+	if err = html.Execute(&htmlBuffer, data); err != nil {
+		return
+	}
+	if err = text.Execute(&textBuffer, data); err != nil {
+		return
+	}
+
+	return htmlBuffer.String(), textBuffer.String(), nil
+}
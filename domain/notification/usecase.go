@@ -0,0 +1,157 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/jwt"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type NotificationUsecase interface {
+	Follow(ctx context.Context, params FollowRequest) (resp response.Response)
+	Unfollow(ctx context.Context, params FollowRequest) (resp response.Response)
+	GetPreference(ctx context.Context) (resp response.Response)
+	UpdatePreference(ctx context.Context, params UpdatePreferenceRequest) (resp response.Response)
+	Unsubscribe(ctx context.Context, params UnsubscribeRequest) (resp response.Response)
+}
+
+type notificationUsecaseImpl struct {
+	location    *time.Location
+	jwt         jwt.JSONWebToken
+	repository  NotificationRepository
+	accountRepo account.AccountRepository
+}
+
+func NewNotificationUsecase(
+	location *time.Location,
+	jwt jwt.JSONWebToken,
+	repository NotificationRepository,
+	accountRepo account.AccountRepository,
+) NotificationUsecase {
+	return &notificationUsecaseImpl{
+		location:    location,
+		jwt:         jwt,
+		repository:  repository,
+		accountRepo: accountRepo,
+	}
+}
+
+func (u *notificationUsecaseImpl) findAccount(ctx context.Context) (account entity.Account, resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return account, response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return account, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return account, nil
+}
+
+func (u *notificationUsecaseImpl) Follow(ctx context.Context, params FollowRequest) (resp response.Response) {
+	follower, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	if follower.ID == params.AuthorID {
+		return response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+	}
+
+	_, err := u.accountRepo.FindByID(ctx, params.AuthorID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	err = u.repository.Follow(ctx, follower.ID, params.AuthorID, time.Now().In(u.location))
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+func (u *notificationUsecaseImpl) Unfollow(ctx context.Context, params FollowRequest) (resp response.Response) {
+	follower, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	err := u.repository.Unfollow(ctx, follower.ID, params.AuthorID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+func (u *notificationUsecaseImpl) GetPreference(ctx context.Context) (resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	preference, err := u.repository.FindPreference(ctx, account.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, preference)
+}
+
+func (u *notificationUsecaseImpl) UpdatePreference(ctx context.Context, params UpdatePreferenceRequest) (resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	return u.savePreference(ctx, account.ID, *params.EmailOnPublish)
+}
+
+// Unsubscribe opts the account in the token out of the emails of its topic, no sign in is needed.
+// Opting out of mention emails makes the account no longer mentionable at all.
+func (u *notificationUsecaseImpl) Unsubscribe(ctx context.Context, params UnsubscribeRequest) (resp response.Response) {
+	claims := UnsubscribeClaims{}
+	_, err := u.jwt.Parse(ctx, params.Token, &claims)
+	if err != nil || !claims.VerifyAudience(UnsubscribeAudience, true) || claims.AccountID < 1 {
+		return response.Error(response.StatusUnauthorized, nil, exception.ErrUnauthorized)
+	}
+
+	if claims.Topic == UnsubscribeTopicMentions {
+		err = u.accountRepo.UpdateMentionable(ctx, claims.AccountID, false)
+		if err != nil {
+			return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+		}
+
+		return response.Success(response.StatusOK, account.MentionPreferenceResponse{Mentionable: false})
+	}
+
+	return u.savePreference(ctx, claims.AccountID, false)
+}
+
+func (u *notificationUsecaseImpl) savePreference(ctx context.Context, accountID int64, emailOnPublish bool) (resp response.Response) {
+	now := time.Now().In(u.location)
+	preference := Preference{
+		AccountID:      accountID,
+		EmailOnPublish: emailOnPublish,
+		UpdatedAt:      &now,
+	}
+
+	err := u.repository.SavePreference(ctx, preference)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, preference)
+}
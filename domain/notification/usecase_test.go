@@ -0,0 +1,109 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/notification"
+	notificationMocks "github.com/sangianpatrick/devoria-article-service/domain/notification/mocks"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	jwtMocks "github.com/sangianpatrick/devoria-article-service/jwt/mocks"
+)
+
+func withEmail(email string) context.Context {
+	return context.WithValue(context.TODO(), entity.EmailCtx, email)
+}
+
+func TestUsecaseFollow_Success(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "jane.doe@email.com").Return(entity.Account{ID: 2}, nil)
+	accountRepository.On("FindByID", mock.Anything, int64(1)).Return(entity.Account{ID: 1}, nil)
+
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("Follow", mock.Anything, int64(2), int64(1), mock.AnythingOfType("time.Time")).Return(nil)
+
+	usecase := notification.NewNotificationUsecase(location, new(jwtMocks.JSONWebToken), repository, accountRepository)
+	resp := usecase.Follow(withEmail("jane.doe@email.com"), notification.FollowRequest{AuthorID: 1})
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
+
+func TestUsecaseFollow_Self(t *testing.T) {
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("FindByEmail", mock.Anything, "john.doe@email.com").Return(entity.Account{ID: 1}, nil)
+
+	repository := new(notificationMocks.NotificationRepository)
+
+	usecase := notification.NewNotificationUsecase(location, new(jwtMocks.JSONWebToken), repository, accountRepository)
+	resp := usecase.Follow(withEmail("john.doe@email.com"), notification.FollowRequest{AuthorID: 1})
+
+	assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	repository.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUsecaseUnsubscribe_Success(t *testing.T) {
+	jsonWebToken := new(jwtMocks.JSONWebToken)
+	jsonWebToken.On("Parse", mock.Anything, "tok", mock.Anything).Run(func(args mock.Arguments) {
+		claims := args.Get(2).(*notification.UnsubscribeClaims)
+		claims.Audience = notification.UnsubscribeAudience
+		claims.AccountID = 2
+	}).Return(&jwtgo.Token{Valid: true}, nil)
+
+	repository := new(notificationMocks.NotificationRepository)
+	repository.On("SavePreference", mock.Anything, mock.MatchedBy(func(preference notification.Preference) bool {
+		return preference.AccountID == 2 && !preference.EmailOnPublish && preference.UpdatedAt != nil
+	})).Return(nil)
+
+	usecase := notification.NewNotificationUsecase(location, jsonWebToken, repository, new(accountMocks.AccountRepository))
+	resp := usecase.Unsubscribe(context.TODO(), notification.UnsubscribeRequest{Token: "tok"})
+
+	assert.NoError(t, resp.Err())
+	repository.AssertExpectations(t)
+}
+
+func TestUsecaseUnsubscribe_Mentions(t *testing.T) {
+	jsonWebToken := new(jwtMocks.JSONWebToken)
+	jsonWebToken.On("Parse", mock.Anything, "tok", mock.Anything).Run(func(args mock.Arguments) {
+		claims := args.Get(2).(*notification.UnsubscribeClaims)
+		claims.Audience = notification.UnsubscribeAudience
+		claims.AccountID = 2
+		claims.Topic = notification.UnsubscribeTopicMentions
+	}).Return(&jwtgo.Token{Valid: true}, nil)
+
+	repository := new(notificationMocks.NotificationRepository)
+	accountRepository := new(accountMocks.AccountRepository)
+	accountRepository.On("UpdateMentionable", mock.Anything, int64(2), false).Return(nil)
+
+	usecase := notification.NewNotificationUsecase(location, jsonWebToken, repository, accountRepository)
+	resp := usecase.Unsubscribe(context.TODO(), notification.UnsubscribeRequest{Token: "tok"})
+
+	assert.NoError(t, resp.Err())
+	accountRepository.AssertExpectations(t)
+	repository.AssertNotCalled(t, "SavePreference", mock.Anything, mock.Anything)
+}
+
+func TestUsecaseUnsubscribe_WrongAudience(t *testing.T) {
+	jsonWebToken := new(jwtMocks.JSONWebToken)
+	jsonWebToken.On("Parse", mock.Anything, "tok", mock.Anything).Run(func(args mock.Arguments) {
+		claims := args.Get(2).(*notification.UnsubscribeClaims)
+		claims.Audience = "preview"
+		claims.AccountID = 2
+		claims.ExpiresAt = time.Now().Add(time.Hour).Unix()
+	}).Return(&jwtgo.Token{Valid: true}, nil)
+
+	repository := new(notificationMocks.NotificationRepository)
+
+	usecase := notification.NewNotificationUsecase(location, jsonWebToken, repository, new(accountMocks.AccountRepository))
+	resp := usecase.Unsubscribe(context.TODO(), notification.UnsubscribeRequest{Token: "tok"})
+
+	assert.Equal(t, exception.ErrUnauthorized, resp.Err())
+	repository.AssertNotCalled(t, "SavePreference", mock.Anything, mock.Anything)
+}
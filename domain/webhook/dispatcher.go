@@ -0,0 +1,237 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/event"
+)
+
+// Headers sent with every delivery. The signature is the hex HMAC-SHA256 of `<timestamp>.<body>`
+// keyed with the webhook secret, receivers should also reject stale timestamps.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxErrorLength keeps the delivery log readable when a transport error is long.
+const maxErrorLength = 500
+
+// maxDrainLength is how much of a response is read to reuse the connection.
+const maxDrainLength = 64 * 1024
+
+// Sign computes the signature header value of a delivery body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher queues deliveries for published events and sends them with exponential backoff.
+type Dispatcher struct {
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	location    *time.Location
+	client      *http.Client
+	repository  WebhookRepository
+}
+
+func NewDispatcher(
+	interval time.Duration,
+	maxAttempts int,
+	backoff time.Duration,
+	location *time.Location,
+	client *http.Client,
+	repository WebhookRepository,
+) *Dispatcher {
+	return &Dispatcher{
+		interval:    interval,
+		batchSize:   50,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		maxBackoff:  time.Hour * 6,
+		location:    location,
+		client:      client,
+		repository:  repository,
+	}
+}
+
+// Subscribe makes the dispatcher queue a delivery for every subscribable event published on the bus.
+func (d *Dispatcher) Subscribe(publisher *event.InMemoryPublisher) {
+	for _, eventType := range SubscribableEvents {
+		publisher.Subscribe(eventType, d.Enqueue)
+	}
+}
+
+// Enqueue queues the event for every webhook that should receive it.
+func (d *Dispatcher) Enqueue(ctx context.Context, evt event.Event) (err error) {
+	var article struct {
+		AuthorID int64 `json:"authorId"`
+	}
+	if err = json.Unmarshal(evt.Payload, &article); err != nil {
+		log.Println(err)
+		return nil
+	}
+
+	webhooks, err := d.repository.FindSubscribed(ctx, evt.Type, article.AuthorID)
+	if err != nil || len(webhooks) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return
+	}
+
+	now := time.Now().In(d.location)
+	deliveries := make([]Delivery, len(webhooks))
+	for i, webhook := range webhooks {
+		deliveries[i] = Delivery{
+			WebhookID:     webhook.ID,
+			EventID:       evt.ID,
+			EventType:     evt.Type,
+			Payload:       body,
+			Status:        DeliveryStatusPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+		}
+	}
+
+	return d.repository.SaveDeliveries(ctx, deliveries)
+}
+
+// Run sends the due deliveries on every tick until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchDue(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// DispatchDue attempts every delivery whose next attempt has come.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (sent int, err error) {
+	due, err := d.repository.FindDueDeliveries(ctx, time.Now().In(d.location), d.batchSize)
+	if err != nil {
+		return
+	}
+
+	for _, delivery := range due {
+		updated := d.attempt(ctx, delivery)
+
+		if err := d.repository.UpdateDelivery(ctx, updated); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		if updated.Status == DeliveryStatusSucceeded {
+			sent++
+		}
+	}
+
+	return
+}
+
+// attempt sends the delivery once and works out its next state.
+func (d *Dispatcher) attempt(ctx context.Context, due DueDelivery) (delivery Delivery) {
+	delivery = due.Delivery
+	delivery.Attempts++
+
+	statusCode, err := d.send(ctx, due)
+
+	now := time.Now().In(d.location)
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = ""
+
+	if err == nil {
+		delivery.Status = DeliveryStatusSucceeded
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if len(delivery.LastError) > maxErrorLength {
+		delivery.LastError = delivery.LastError[:maxErrorLength]
+	}
+
+	if delivery.Attempts >= d.maxAttempts {
+		delivery.Status = DeliveryStatusDead
+		delivery.NextAttemptAt = nil
+		return
+	}
+
+	next := now.Add(d.backoffAfter(delivery.Attempts))
+	delivery.NextAttemptAt = &next
+
+	return
+}
+
+// backoffAfter doubles the wait after every failed attempt up to the ceiling.
+func (d *Dispatcher) backoffAfter(attempts int) time.Duration {
+	wait := d.backoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= d.maxBackoff {
+			return d.maxBackoff
+		}
+	}
+	return wait
+}
+
+func (d *Dispatcher) send(ctx context.Context, due DueDelivery) (statusCode int, err error) {
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, due.URL, bytes.NewReader(due.Payload))
+	if err != nil {
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(due.EventType))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(due.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(due.Secret, timestamp, due.Payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+
+	// The body is drained but never kept, the delivery log must not let the owner read what the receiver answered.
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, maxDrainLength))
+
+	statusCode = res.StatusCode
+	if statusCode < 200 || statusCode >= 300 {
+		err = fmt.Errorf("receiver answered %d", statusCode)
+	}
+
+	return
+}
@@ -0,0 +1,189 @@
+package webhook_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/webhook"
+	webhookMocks "github.com/sangianpatrick/devoria-article-service/domain/webhook/mocks"
+	"github.com/sangianpatrick/devoria-article-service/event"
+)
+
+var location, _ = time.LoadLocation("Asia/Jakarta")
+
+func dueDelivery(url string, attempts int) webhook.DueDelivery {
+	now := time.Now()
+	return webhook.DueDelivery{
+		Delivery: webhook.Delivery{
+			ID:            5,
+			WebhookID:     1,
+			EventID:       9,
+			EventType:     event.ArticlePublished,
+			Payload:       []byte(`{"id":9,"type":"article.published"}`),
+			Status:        webhook.DeliveryStatusPending,
+			Attempts:      attempts,
+			NextAttemptAt: &now,
+		},
+		URL:    url,
+		Secret: "s3cr3t",
+	}
+}
+
+func TestDispatcherDispatchDue_SignsAndSucceeds(t *testing.T) {
+	var verified bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+
+		verified = r.Header.Get(webhook.HeaderSignature) == webhook.Sign("s3cr3t", timestamp, body) &&
+			r.Header.Get(webhook.HeaderEvent) == string(event.ArticlePublished)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindDueDeliveries", mock.Anything, mock.AnythingOfType("time.Time"), 50).Return([]webhook.DueDelivery{dueDelivery(receiver.URL, 0)}, nil)
+	repository.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(delivery webhook.Delivery) bool {
+		return delivery.Status == webhook.DeliveryStatusSucceeded && delivery.Attempts == 1 && delivery.DeliveredAt != nil && delivery.LastStatusCode == http.StatusNoContent
+	})).Return(nil)
+
+	dispatcher := webhook.NewDispatcher(time.Second, 3, time.Minute, location, receiver.Client(), repository)
+
+	sent, err := dispatcher.DispatchDue(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.True(t, verified, "receiver should be able to verify the signature")
+	repository.AssertExpectations(t)
+}
+
+func TestDispatcherDispatchDue_BacksOffExponentially(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal secret", http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	before := time.Now()
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindDueDeliveries", mock.Anything, mock.AnythingOfType("time.Time"), 50).Return([]webhook.DueDelivery{dueDelivery(receiver.URL, 1)}, nil)
+	repository.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(delivery webhook.Delivery) bool {
+		//Second failure waits twice the base backoff
+		wait := delivery.NextAttemptAt.Sub(before)
+		return delivery.Status == webhook.DeliveryStatusPending && delivery.Attempts == 2 &&
+			delivery.LastStatusCode == http.StatusServiceUnavailable &&
+			!strings.Contains(delivery.LastError, "internal secret") &&
+			wait >= 2*time.Minute && wait < 3*time.Minute
+	})).Return(nil)
+
+	dispatcher := webhook.NewDispatcher(time.Second, 3, time.Minute, location, receiver.Client(), repository)
+
+	sent, err := dispatcher.DispatchDue(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	repository.AssertExpectations(t)
+}
+
+func TestDispatcherDispatchDue_DeadLetter(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindDueDeliveries", mock.Anything, mock.AnythingOfType("time.Time"), 50).Return([]webhook.DueDelivery{dueDelivery(receiver.URL, 2)}, nil)
+	repository.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(delivery webhook.Delivery) bool {
+		return delivery.Status == webhook.DeliveryStatusDead && delivery.Attempts == 3 && delivery.NextAttemptAt == nil
+	})).Return(nil)
+
+	dispatcher := webhook.NewDispatcher(time.Second, 3, time.Minute, location, receiver.Client(), repository)
+
+	_, err := dispatcher.DispatchDue(context.TODO())
+
+	assert.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestDispatcherEnqueue(t *testing.T) {
+	evt, _ := event.New(event.ArticlePublished, 9, map[string]int64{"id": 9, "authorId": 3}, time.Now())
+	evt.ID = 42
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindSubscribed", mock.Anything, event.ArticlePublished, int64(3)).Return([]webhook.Webhook{{ID: 1}, {ID: 2}}, nil)
+	repository.On("SaveDeliveries", mock.Anything, mock.MatchedBy(func(deliveries []webhook.Delivery) bool {
+		return len(deliveries) == 2 && deliveries[0].EventID == 42 && deliveries[1].WebhookID == 2 &&
+			deliveries[0].Status == webhook.DeliveryStatusPending
+	})).Return(nil)
+
+	dispatcher := webhook.NewDispatcher(time.Second, 3, time.Minute, location, http.DefaultClient, repository)
+
+	err := dispatcher.Enqueue(context.TODO(), evt)
+
+	assert.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestDispatcherDispatchDue_RefusesInternalReceivers(t *testing.T) {
+	var called bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindDueDeliveries", mock.Anything, mock.AnythingOfType("time.Time"), 50).Return([]webhook.DueDelivery{dueDelivery(receiver.URL, 0)}, nil)
+	repository.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(delivery webhook.Delivery) bool {
+		return delivery.Status == webhook.DeliveryStatusPending && delivery.LastStatusCode == 0 &&
+			strings.Contains(delivery.LastError, webhook.ErrForbiddenAddress.Error())
+	})).Return(nil)
+
+	//The receiver listens on loopback, the client must refuse to dial it
+	dispatcher := webhook.NewDispatcher(time.Second, 3, time.Minute, location, webhook.NewClient(time.Second), repository)
+
+	_, err := dispatcher.DispatchDue(context.TODO())
+
+	assert.NoError(t, err)
+	assert.False(t, called)
+	repository.AssertExpectations(t)
+}
+
+func TestNewClient_DoesNotFollowRedirects(t *testing.T) {
+	client := webhook.NewClient(time.Second)
+
+	req := httptest.NewRequest(http.MethodPost, "http://203.0.113.10/hooks", nil)
+	err := client.CheckRedirect(req, []*http.Request{req})
+
+	assert.Equal(t, http.ErrUseLastResponse, err)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for address, public := range map[string]bool{
+		"203.0.113.10":     true,
+		"2001:db8::1":      true,
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"::1":              false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, public, webhook.IsPublicIP(net.ParseIP(address)), address)
+	}
+}
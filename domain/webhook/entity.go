@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/event"
+)
+
+// SubscribableEvents are the events a webhook may subscribe to.
+var SubscribableEvents = []event.Type{
+	event.ArticlePublished,
+	event.ArticleUpdated,
+	event.ArticleArchived,
+}
+
+// Webhook is an endpoint an account registered to be notified of article events.
+type Webhook struct {
+	ID        int64        `json:"id"`
+	AccountID int64        `json:"accountId"`
+	URL       string       `json:"url"`
+	Secret    string       `json:"secret,omitempty"`
+	Events    []event.Type `json:"events"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// DeliveryStatus is a type of webhook delivery current status.
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "PENDING"
+	DeliveryStatusSucceeded DeliveryStatus = "SUCCEEDED"
+	DeliveryStatusDead      DeliveryStatus = "DEAD"
+)
+
+// Delivery is one event sent to one webhook, it keeps the outcome of the latest attempt.
+type Delivery struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhookId"`
+	EventID        int64           `json:"eventId"`
+	EventType      event.Type      `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         DeliveryStatus  `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt"`
+	LastStatusCode int             `json:"lastStatusCode,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	DeliveredAt    *time.Time      `json:"deliveredAt"`
+}
+
+// DueDelivery is a pending delivery with the endpoint it goes to.
+type DueDelivery struct {
+	Delivery
+	URL    string
+	Secret string
+}
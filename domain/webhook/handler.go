@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type WebhookHTTPHandler struct {
+	Validate *validator.Validate
+	Usecase  WebhookUsecase
+}
+
+func NewWebhookHTTPHandler(
+	router *mux.Router,
+	bearerAuthMiddleware middleware.RouteMiddlewareBearer,
+	validate *validator.Validate,
+	usecase WebhookUsecase,
+) {
+	handler := &WebhookHTTPHandler{
+		Validate: validate,
+		Usecase:  usecase,
+	}
+
+	//Get
+	router.HandleFunc("/v1/webhooks", bearerAuthMiddleware.VerifyBearer(handler.GetAll)).Methods(http.MethodGet)
+	router.HandleFunc("/v1/webhooks/{id:[0-9]+}/deliveries", bearerAuthMiddleware.VerifyBearer(handler.GetDeliveries)).Methods(http.MethodGet)
+	//Post
+	router.HandleFunc("/v1/webhooks", bearerAuthMiddleware.VerifyBearer(handler.Create)).Methods(http.MethodPost)
+	router.HandleFunc("/v1/webhooks/{id:[0-9]+}/deliveries/{deliveryId:[0-9]+}/redeliver", bearerAuthMiddleware.VerifyBearer(handler.Redeliver)).Methods(http.MethodPost)
+	//Delete
+	router.HandleFunc("/v1/webhooks/{id:[0-9]+}", bearerAuthMiddleware.VerifyBearer(handler.Delete)).Methods(http.MethodDelete)
+}
+
+func (handler *WebhookHTTPHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params CreateWebhookRequest
+	var ctx = r.Context()
+
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Create(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *WebhookHTTPHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var ctx = r.Context()
+
+	resp = handler.Usecase.GetAll(ctx)
+	resp.JSON(w)
+}
+
+func (handler *WebhookHTTPHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params DeleteWebhookRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.ID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Delete(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *WebhookHTTPHandler) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params GetDeliveriesRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+	id := path["id"]
+
+	convertedID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.WebhookID = convertedID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.GetDeliveries(ctx, params)
+	resp.JSON(w)
+}
+
+func (handler *WebhookHTTPHandler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	var resp response.Response
+	var params RedeliverRequest
+	var ctx = r.Context()
+	path := mux.Vars(r)
+
+	webhookID, err := strconv.ParseInt(path["id"], 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	deliveryID, err := strconv.ParseInt(path["deliveryId"], 10, 64)
+	if err != nil {
+		resp = response.Error(response.StatusUnprocessabelEntity, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	params.WebhookID = webhookID
+	params.DeliveryID = deliveryID
+
+	err = handler.Validate.StructCtx(ctx, params)
+	if err != nil {
+		resp = response.Error(response.StatusInvalidPayload, nil, err)
+		resp.JSON(w)
+		return
+	}
+
+	resp = handler.Usecase.Redeliver(ctx, params)
+	resp.JSON(w)
+}
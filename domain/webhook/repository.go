@@ -0,0 +1,418 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+type WebhookRepository interface {
+	Save(ctx context.Context, webhook Webhook) (ID int64, err error)
+	Delete(ctx context.Context, ID int64, accountID int64) (err error)
+	FindByID(ctx context.Context, ID int64) (webhook Webhook, err error)
+	FindByAccount(ctx context.Context, accountID int64) (webhooks []Webhook, err error)
+	FindSubscribed(ctx context.Context, eventType event.Type, authorID int64) (webhooks []Webhook, err error)
+	SaveDeliveries(ctx context.Context, deliveries []Delivery) (err error)
+	FindDelivery(ctx context.Context, ID int64) (delivery Delivery, err error)
+	FindDeliveries(ctx context.Context, webhookID int64) (deliveries []Delivery, err error)
+	FindDueDeliveries(ctx context.Context, at time.Time, limit int) (deliveries []DueDelivery, err error)
+	UpdateDelivery(ctx context.Context, delivery Delivery) (err error)
+}
+
+type webhookRepositoryImpl struct {
+	db                *sql.DB
+	tableName         string
+	deliveryTableName string
+	accountTableName  string
+}
+
+func NewWebhookRepository(db *sql.DB, tableName, deliveryTableName, accountTableName string) WebhookRepository {
+	return &webhookRepositoryImpl{
+		db:                db,
+		tableName:         tableName,
+		deliveryTableName: deliveryTableName,
+		accountTableName:  accountTableName,
+	}
+}
+
+func joinEvents(events []event.Type) string {
+	names := make([]string, len(events))
+	for i, eventType := range events {
+		names[i] = string(eventType)
+	}
+	return strings.Join(names, ",")
+}
+
+func splitEvents(value string) (events []event.Type) {
+	for _, name := range strings.Split(value, ",") {
+		if name != "" {
+			events = append(events, event.Type(name))
+		}
+	}
+	return
+}
+
+func (r *webhookRepositoryImpl) Save(ctx context.Context, webhook Webhook) (ID int64, err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (accountId, url, secret, events, createdAt) VALUES (?, ?, ?, ?, ?)`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		webhook.AccountID,
+		webhook.URL,
+		webhook.Secret,
+		joinEvents(webhook.Events),
+		webhook.CreatedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	ID, _ = result.LastInsertId()
+
+	return
+}
+
+func (r *webhookRepositoryImpl) Delete(ctx context.Context, ID int64, accountID int64) (err error) {
+	command := fmt.Sprintf(`DELETE FROM %s WHERE id = ? AND accountId = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, ID, accountID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *webhookRepositoryImpl) FindByID(ctx context.Context, ID int64) (webhook Webhook, err error) {
+	query := fmt.Sprintf(`SELECT id, accountId, url, secret, events, createdAt FROM %s WHERE id = ?`, r.tableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	var events string
+
+	err = stmt.QueryRowContext(ctx, ID).Scan(
+		&webhook.ID,
+		&webhook.AccountID,
+		&webhook.URL,
+		&webhook.Secret,
+		&events,
+		&webhook.CreatedAt,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrNotFound
+		return
+	}
+
+	webhook.Events = splitEvents(events)
+
+	return
+}
+
+func (r *webhookRepositoryImpl) FindByAccount(ctx context.Context, accountID int64) (webhooks []Webhook, err error) {
+	query := fmt.Sprintf(`SELECT id, accountId, url, secret, events, createdAt FROM %s WHERE accountId = ? ORDER BY id`, r.tableName)
+	return r.findMany(ctx, query, accountID)
+}
+
+// FindSubscribed returns the webhooks that get the event of an article by the author,
+// admins receive every article while the other accounts only receive their own.
+func (r *webhookRepositoryImpl) FindSubscribed(ctx context.Context, eventType event.Type, authorID int64) (webhooks []Webhook, err error) {
+	query := fmt.Sprintf(
+		`SELECT w.id, w.accountId, w.url, w.secret, w.events, w.createdAt FROM %s w JOIN %s a ON a.id = w.accountId WHERE FIND_IN_SET(?, w.events) > 0 AND (w.accountId = ? OR a.role = ?) ORDER BY w.id`,
+		r.tableName,
+		r.accountTableName,
+	)
+	return r.findMany(ctx, query, eventType, authorID, entity.AccountRoleAdmin)
+}
+
+func (r *webhookRepositoryImpl) findMany(ctx context.Context, query string, args ...interface{}) (webhooks []Webhook, err error) {
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		webhook := Webhook{}
+		var events string
+
+		err = rows.Scan(
+			&webhook.ID,
+			&webhook.AccountID,
+			&webhook.URL,
+			&webhook.Secret,
+			&events,
+			&webhook.CreatedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		webhook.Events = splitEvents(events)
+		webhooks = append(webhooks, webhook)
+	}
+
+	return
+}
+
+// SaveDeliveries ignores deliveries already queued, the outbox relay may hand over the same event twice.
+func (r *webhookRepositoryImpl) SaveDeliveries(ctx context.Context, deliveries []Delivery) (err error) {
+	command := fmt.Sprintf(`INSERT IGNORE INTO %s (webhookId, eventId, eventType, payload, status, attempts, nextAttemptAt, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, r.deliveryTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	for _, delivery := range deliveries {
+		_, err = stmt.ExecContext(
+			ctx,
+			delivery.WebhookID,
+			delivery.EventID,
+			delivery.EventType,
+			string(delivery.Payload),
+			delivery.Status,
+			delivery.Attempts,
+			delivery.NextAttemptAt,
+			delivery.CreatedAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+	}
+
+	return
+}
+
+const deliveryColumns = `d.id, d.webhookId, d.eventId, d.eventType, d.payload, d.status, d.attempts, d.nextAttemptAt, d.lastStatusCode, d.lastError, d.createdAt, d.deliveredAt`
+
+func scanDelivery(scanner interface{ Scan(...interface{}) error }, delivery *Delivery, extra ...interface{}) (err error) {
+	var payload string
+	var nextAttemptAt sql.NullTime
+	var lastStatusCode sql.NullInt64
+	var lastError sql.NullString
+	var deliveredAt sql.NullTime
+
+	dest := []interface{}{
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&nextAttemptAt,
+		&lastStatusCode,
+		&lastError,
+		&delivery.CreatedAt,
+		&deliveredAt,
+	}
+
+	err = scanner.Scan(append(dest, extra...)...)
+	if err != nil {
+		return
+	}
+
+	delivery.Payload = []byte(payload)
+	delivery.LastStatusCode = int(lastStatusCode.Int64)
+	delivery.LastError = lastError.String
+
+	if nextAttemptAt.Valid {
+		delivery.NextAttemptAt = &nextAttemptAt.Time
+	}
+
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+
+	return
+}
+
+func (r *webhookRepositoryImpl) FindDelivery(ctx context.Context, ID int64) (delivery Delivery, err error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s d WHERE d.id = ?`, deliveryColumns, r.deliveryTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	err = scanDelivery(stmt.QueryRowContext(ctx, ID), &delivery)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
+
+func (r *webhookRepositoryImpl) FindDeliveries(ctx context.Context, webhookID int64) (deliveries []Delivery, err error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s d WHERE d.webhookId = ? ORDER BY d.id DESC LIMIT 100`, deliveryColumns, r.deliveryTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, webhookID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery := Delivery{}
+
+		err = scanDelivery(rows, &delivery)
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return
+}
+
+func (r *webhookRepositoryImpl) FindDueDeliveries(ctx context.Context, at time.Time, limit int) (deliveries []DueDelivery, err error) {
+	query := fmt.Sprintf(
+		`SELECT %s, w.url, w.secret FROM %s d JOIN %s w ON w.id = d.webhookId WHERE d.status = ? AND d.nextAttemptAt <= ? ORDER BY d.nextAttemptAt LIMIT ?`,
+		deliveryColumns,
+		r.deliveryTableName,
+		r.tableName,
+	)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, DeliveryStatusPending, at, limit)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		due := DueDelivery{}
+
+		err = scanDelivery(rows, &due.Delivery, &due.URL, &due.Secret)
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		deliveries = append(deliveries, due)
+	}
+
+	return
+}
+
+func (r *webhookRepositoryImpl) UpdateDelivery(ctx context.Context, delivery Delivery) (err error) {
+	command := fmt.Sprintf(`UPDATE %s SET status = ?, attempts = ?, nextAttemptAt = ?, lastStatusCode = ?, lastError = ?, deliveredAt = ? WHERE id = ?`, r.deliveryTableName)
+	stmt, err := database.Connection(ctx, r.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastStatusCode,
+		delivery.LastError,
+		delivery.DeliveredAt,
+		delivery.ID,
+	)
+
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		err = exception.ErrNotFound
+		return
+	}
+
+	return
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import "github.com/sangianpatrick/devoria-article-service/event"
+
+// CreateWebhookRequest is model for registering a webhook endpoint.
+type CreateWebhookRequest struct {
+	URL    string       `json:"url" validate:"required,url,startswith=http"`
+	Events []event.Type `json:"events" validate:"required,min=1,dive,oneof=article.published article.updated article.archived"`
+}
+
+// DeleteWebhookRequest is model for removing a webhook endpoint.
+type DeleteWebhookRequest struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+// GetDeliveriesRequest is model for reading the delivery log of a webhook.
+type GetDeliveriesRequest struct {
+	WebhookID int64 `json:"webhookId" validate:"required"`
+}
+
+// RedeliverRequest is model for sending a delivery again.
+type RedeliverRequest struct {
+	WebhookID  int64 `json:"webhookId" validate:"required"`
+	DeliveryID int64 `json:"deliveryId" validate:"required"`
+}
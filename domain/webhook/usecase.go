@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account"
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+type WebhookUsecase interface {
+	Create(ctx context.Context, params CreateWebhookRequest) (resp response.Response)
+	GetAll(ctx context.Context) (resp response.Response)
+	Delete(ctx context.Context, params DeleteWebhookRequest) (resp response.Response)
+	GetDeliveries(ctx context.Context, params GetDeliveriesRequest) (resp response.Response)
+	Redeliver(ctx context.Context, params RedeliverRequest) (resp response.Response)
+}
+
+type webhookUsecaseImpl struct {
+	location    *time.Location
+	resolver    HostResolver
+	repository  WebhookRepository
+	accountRepo account.AccountRepository
+}
+
+func NewWebhookUsecase(
+	location *time.Location,
+	resolver HostResolver,
+	repository WebhookRepository,
+	accountRepo account.AccountRepository,
+) WebhookUsecase {
+	return &webhookUsecaseImpl{
+		location:    location,
+		resolver:    resolver,
+		repository:  repository,
+		accountRepo: accountRepo,
+	}
+}
+
+func (u *webhookUsecaseImpl) findAccount(ctx context.Context) (account entity.Account, resp response.Response) {
+	email := ctx.Value(entity.EmailCtx).(string)
+	account, err := u.accountRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return account, response.Error(response.StatusInvalidPayload, nil, exception.ErrBadRequest)
+		}
+		return account, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return account, nil
+}
+
+// findOwnedWebhook loads a webhook of the signed in account, other accounts' webhooks look missing.
+func (u *webhookUsecaseImpl) findOwnedWebhook(ctx context.Context, ID int64) (webhook Webhook, resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return webhook, resp
+	}
+
+	webhook, err := u.repository.FindByID(ctx, ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return webhook, response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return webhook, response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if webhook.AccountID != account.ID {
+		return webhook, response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	return webhook, nil
+}
+
+func (u *webhookUsecaseImpl) Create(ctx context.Context, params CreateWebhookRequest) (resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	if err := CheckURL(ctx, u.resolver, params.URL); err != nil {
+		return response.Error(response.StatusInvalidPayload, nil, err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	webhook := Webhook{}
+	webhook.AccountID = account.ID
+	webhook.URL = params.URL
+	webhook.Secret = hex.EncodeToString(secret)
+	webhook.Events = params.Events
+	webhook.CreatedAt = time.Now().In(u.location)
+
+	ID, err := u.repository.Save(ctx, webhook)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	webhook.ID = ID
+
+	//The secret is only shown once, receivers keep it to verify the signatures
+	return response.Success(response.StatusCreated, webhook)
+}
+
+func (u *webhookUsecaseImpl) GetAll(ctx context.Context) (resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	webhooks, err := u.repository.FindByAccount(ctx, account.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+
+	return response.Success(response.StatusOK, webhooks)
+}
+
+func (u *webhookUsecaseImpl) Delete(ctx context.Context, params DeleteWebhookRequest) (resp response.Response) {
+	account, resp := u.findAccount(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	err := u.repository.Delete(ctx, params.ID, account.ID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+func (u *webhookUsecaseImpl) GetDeliveries(ctx context.Context, params GetDeliveriesRequest) (resp response.Response) {
+	webhook, resp := u.findOwnedWebhook(ctx, params.WebhookID)
+	if resp != nil {
+		return resp
+	}
+
+	deliveries, err := u.repository.FindDeliveries(ctx, webhook.ID)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, deliveries)
+}
+
+func (u *webhookUsecaseImpl) Redeliver(ctx context.Context, params RedeliverRequest) (resp response.Response) {
+	webhook, resp := u.findOwnedWebhook(ctx, params.WebhookID)
+	if resp != nil {
+		return resp
+	}
+
+	delivery, err := u.repository.FindDelivery(ctx, params.DeliveryID)
+	if err != nil {
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+		}
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	if delivery.WebhookID != webhook.ID {
+		return response.Error(response.StatusNotFound, nil, exception.ErrNotFound)
+	}
+
+	//A fresh budget of attempts, the dispatcher picks it up on its next tick
+	now := time.Now().In(u.location)
+	delivery.Status = DeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+
+	err = u.repository.UpdateDelivery(ctx, delivery)
+	if err != nil {
+		return response.Error(response.StatusUnexpectedError, nil, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, delivery)
+}
@@ -0,0 +1,103 @@
+package webhook_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	accountMocks "github.com/sangianpatrick/devoria-article-service/domain/account/mocks"
+	"github.com/sangianpatrick/devoria-article-service/domain/webhook"
+	webhookMocks "github.com/sangianpatrick/devoria-article-service/domain/webhook/mocks"
+	"github.com/sangianpatrick/devoria-article-service/event"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+func TestUsecaseCreate_GeneratesSecret(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "author@devoria.id").Return(entity.Account{ID: 3}, nil)
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("Save", mock.Anything, mock.MatchedBy(func(w webhook.Webhook) bool {
+		return w.AccountID == 3 && len(w.Secret) == 64
+	})).Return(int64(1), nil)
+
+	resolver := new(webhookMocks.HostResolver)
+	resolver.On("LookupIPAddr", mock.Anything, "search.devoria.id").Return([]net.IPAddr{{IP: net.ParseIP("203.0.113.10")}}, nil)
+
+	u := webhook.NewWebhookUsecase(location, resolver, repository, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "author@devoria.id")
+
+	resp := u.Create(ctx, webhook.CreateWebhookRequest{URL: "https://search.devoria.id/hooks", Events: []event.Type{event.ArticlePublished}})
+
+	assert.NoError(t, resp.Err())
+	accountRepo.AssertExpectations(t)
+	repository.AssertExpectations(t)
+}
+
+func TestUsecaseCreate_RejectsInternalAddresses(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "author@devoria.id").Return(entity.Account{ID: 3}, nil)
+
+	resolver := new(webhookMocks.HostResolver)
+	resolver.On("LookupIPAddr", mock.Anything, "127.0.0.1").Return([]net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}, nil)
+	resolver.On("LookupIPAddr", mock.Anything, "169.254.169.254").Return([]net.IPAddr{{IP: net.ParseIP("169.254.169.254")}}, nil)
+	//A public name that also resolves to a private address is rejected
+	resolver.On("LookupIPAddr", mock.Anything, "rebind.devoria.id").Return([]net.IPAddr{{IP: net.ParseIP("203.0.113.10")}, {IP: net.ParseIP("10.0.0.5")}}, nil)
+
+	repository := new(webhookMocks.WebhookRepository)
+
+	u := webhook.NewWebhookUsecase(location, resolver, repository, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "author@devoria.id")
+
+	for _, url := range []string{"http://127.0.0.1:6379/", "http://169.254.169.254/latest/meta-data", "https://rebind.devoria.id/hooks"} {
+		resp := u.Create(ctx, webhook.CreateWebhookRequest{URL: url, Events: []event.Type{event.ArticlePublished}})
+		assert.Equal(t, webhook.ErrForbiddenAddress, resp.Err(), url)
+	}
+
+	repository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestUsecaseRedeliver_ResetsDeadDelivery(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "author@devoria.id").Return(entity.Account{ID: 3}, nil)
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindByID", mock.Anything, int64(1)).Return(webhook.Webhook{ID: 1, AccountID: 3}, nil)
+	repository.On("FindDelivery", mock.Anything, int64(5)).Return(webhook.Delivery{ID: 5, WebhookID: 1, Status: webhook.DeliveryStatusDead, Attempts: 8}, nil)
+	repository.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(delivery webhook.Delivery) bool {
+		return delivery.Status == webhook.DeliveryStatusPending && delivery.Attempts == 0 && delivery.NextAttemptAt != nil &&
+			!delivery.NextAttemptAt.After(time.Now())
+	})).Return(nil)
+
+	u := webhook.NewWebhookUsecase(location, new(webhookMocks.HostResolver), repository, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "author@devoria.id")
+
+	resp := u.Redeliver(ctx, webhook.RedeliverRequest{WebhookID: 1, DeliveryID: 5})
+
+	assert.NoError(t, resp.Err())
+	accountRepo.AssertExpectations(t)
+	repository.AssertExpectations(t)
+}
+
+func TestUsecaseRedeliver_OtherAccountsWebhook(t *testing.T) {
+	accountRepo := new(accountMocks.AccountRepository)
+	accountRepo.On("FindByEmail", mock.Anything, "author@devoria.id").Return(entity.Account{ID: 3}, nil)
+
+	repository := new(webhookMocks.WebhookRepository)
+	repository.On("FindByID", mock.Anything, int64(1)).Return(webhook.Webhook{ID: 1, AccountID: 4}, nil)
+
+	u := webhook.NewWebhookUsecase(location, new(webhookMocks.HostResolver), repository, accountRepo)
+	ctx := context.WithValue(context.Background(), entity.EmailCtx, "author@devoria.id")
+
+	resp := u.Redeliver(ctx, webhook.RedeliverRequest{WebhookID: 1, DeliveryID: 5})
+
+	assert.Equal(t, exception.ErrNotFound, resp.Err())
+	repository.AssertNotCalled(t, "UpdateDelivery", mock.Anything, mock.Anything)
+	accountRepo.AssertExpectations(t)
+	repository.AssertExpectations(t)
+}
@@ -0,0 +1,51 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Type names what happened, it is also the topic an event is published to.
+type Type string
+
+const (
+	AccountRegistered Type = "account.registered"
+	ArticleCreated    Type = "article.created"
+	ArticleUpdated    Type = "article.updated"
+	ArticlePublished  Type = "article.published"
+	ArticleArchived   Type = "article.archived"
+)
+
+// Event is a domain event, its ID is assigned by the outbox.
+type Event struct {
+	ID          int64           `json:"id"`
+	Type        Type            `json:"type"`
+	AggregateID int64           `json:"aggregateId"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurredAt"`
+	// Attempts is how many times the relay failed to publish it, it is not part of the event.
+	Attempts int `json:"-"`
+}
+
+// New builds an event with the JSON encoded payload.
+func New(eventType Type, aggregateID int64, payload interface{}, occurredAt time.Time) (evt Event, err error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+
+	evt = Event{
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     encoded,
+		OccurredAt:  occurredAt,
+	}
+
+	return
+}
+
+// Publisher delivers events to the outside world, it must accept the same event more than once.
+type Publisher interface {
+	Publish(ctx context.Context, events []Event) (err error)
+}
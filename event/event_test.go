@@ -0,0 +1,168 @@
+package event_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/event"
+	eventMocks "github.com/sangianpatrick/devoria-article-service/event/mocks"
+)
+
+func TestRelayFlush_MarksPublishedEvents(t *testing.T) {
+	events := []event.Event{
+		{ID: 1, Type: event.ArticleCreated, AggregateID: 10},
+		{ID: 2, Type: event.ArticlePublished, AggregateID: 10},
+	}
+
+	outbox := new(eventMocks.Outbox)
+	outbox.On("Pending", mock.Anything, 100).Return(events, nil).Once()
+	outbox.On("MarkPublished", mock.Anything, []int64{1}, mock.AnythingOfType("time.Time")).Return(nil).Once()
+	outbox.On("MarkPublished", mock.Anything, []int64{2}, mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	publisher := new(eventMocks.Publisher)
+	publisher.On("Publish", mock.Anything, events[:1]).Return(nil).Once()
+	publisher.On("Publish", mock.Anything, events[1:]).Return(nil).Once()
+
+	relay := event.NewRelay(time.Second, 100, 5, time.UTC, outbox, publisher)
+
+	published, err := relay.Flush(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+	outbox.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestRelayFlush_KeepsEventsWhenPublishFails(t *testing.T) {
+	events := []event.Event{
+		{ID: 1, Type: event.AccountRegistered, AggregateID: 3, Attempts: 1},
+		{ID: 2, Type: event.ArticleCreated, AggregateID: 10},
+	}
+
+	outbox := new(eventMocks.Outbox)
+	outbox.On("Pending", mock.Anything, 100).Return(events, nil).Once()
+	outbox.On("MarkFailed", mock.Anything, int64(1), "broker down", (*time.Time)(nil)).Return(nil).Once()
+
+	publisher := new(eventMocks.Publisher)
+	publisher.On("Publish", mock.Anything, events[:1]).Return(errors.New("broker down")).Once()
+
+	relay := event.NewRelay(time.Second, 100, 5, time.UTC, outbox, publisher)
+
+	published, err := relay.Flush(context.TODO())
+
+	//The events behind the failed one wait for it, they keep their order
+	assert.Error(t, err)
+	assert.Equal(t, 0, published)
+	outbox.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything, mock.Anything)
+	outbox.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestRelayFlush_ParksEventOutOfAttempts(t *testing.T) {
+	events := []event.Event{
+		{ID: 1, Type: event.ArticlePublished, AggregateID: 10, Attempts: 4},
+		{ID: 2, Type: event.ArticleCreated, AggregateID: 11},
+	}
+
+	outbox := new(eventMocks.Outbox)
+	outbox.On("Pending", mock.Anything, 100).Return(events, nil).Once()
+	outbox.On("MarkFailed", mock.Anything, int64(1), "handler failed", mock.AnythingOfType("*time.Time")).Return(nil).Once()
+	outbox.On("MarkPublished", mock.Anything, []int64{2}, mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	publisher := new(eventMocks.Publisher)
+	publisher.On("Publish", mock.Anything, events[:1]).Return(errors.New("handler failed")).Once()
+	publisher.On("Publish", mock.Anything, events[1:]).Return(nil).Once()
+
+	relay := event.NewRelay(time.Second, 100, 5, time.UTC, outbox, publisher)
+
+	published, err := relay.Flush(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	outbox.AssertExpectations(t)
+	publisher.AssertExpectations(t)
+}
+
+func TestInMemoryPublisher_DispatchesBySubscription(t *testing.T) {
+	publisher := event.NewInMemoryPublisher()
+
+	var received []event.Event
+	publisher.Subscribe(event.ArticlePublished, func(ctx context.Context, evt event.Event) error {
+		received = append(received, evt)
+		return nil
+	})
+
+	err := publisher.Publish(context.TODO(), []event.Event{
+		{ID: 1, Type: event.ArticleCreated},
+		{ID: 2, Type: event.ArticlePublished},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, received, 1)
+	assert.Equal(t, int64(2), received[0].ID)
+}
+
+func TestFilePublisher_AppendsNDJSON(t *testing.T) {
+	dir, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "events.ndjson")
+	publisher, err := event.NewFilePublisher(path)
+	assert.NoError(t, err)
+
+	evt, _ := event.New(event.ArticleCreated, 7, map[string]string{"title": "Hello"}, time.Now())
+	assert.NoError(t, publisher.Publish(context.TODO(), []event.Event{evt}))
+	assert.NoError(t, publisher.Publish(context.TODO(), []event.Event{evt}))
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var decoded event.Event
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &decoded))
+		assert.Equal(t, event.ArticleCreated, decoded.Type)
+		lines++
+	}
+	assert.Equal(t, 2, lines)
+}
+
+func TestOutboxPending(t *testing.T) {
+	db, sqlMock, _ := sqlmock.New()
+
+	rows := sqlmock.NewRows([]string{"id", "type", "aggregateId", "payload", "occurredAt", "attempts"}).
+		AddRow(int64(1), "article.created", int64(10), `{"id":10}`, time.Now(), 2)
+
+	sqlMock.ExpectPrepare("SELECT id, type, aggregateId, payload, occurredAt, attempts FROM event_outbox WHERE publishedAt IS NULL AND parkedAt IS NULL").
+		ExpectQuery().
+		WithArgs(50).
+		WillReturnRows(rows)
+
+	outbox := event.NewOutbox(db, "event_outbox")
+
+	events, err := outbox.Pending(context.TODO(), 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.JSONEq(t, `{"id":10}`, string(events[0].Payload))
+	assert.Equal(t, 2, events[0].Attempts)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
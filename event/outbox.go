@@ -0,0 +1,166 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/sangianpatrick/devoria-article-service/database"
+	"github.com/sangianpatrick/devoria-article-service/exception"
+)
+
+// maxErrorLength fits the lastError column.
+const maxErrorLength = 500
+
+// Outbox stores events in the same transaction as the change they describe, so neither goes without the other.
+type Outbox interface {
+	Add(ctx context.Context, tx *sql.Tx, events ...Event) (err error)
+	Pending(ctx context.Context, limit int) (events []Event, err error)
+	MarkPublished(ctx context.Context, IDs []int64, at time.Time) (err error)
+	// MarkFailed counts a failed attempt, an event parked at a time is no longer pending.
+	MarkFailed(ctx context.Context, ID int64, lastError string, parkedAt *time.Time) (err error)
+}
+
+type outboxImpl struct {
+	db        *sql.DB
+	tableName string
+}
+
+func NewOutbox(db *sql.DB, tableName string) Outbox {
+	return &outboxImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (o *outboxImpl) Add(ctx context.Context, tx *sql.Tx, events ...Event) (err error) {
+	command := fmt.Sprintf(`INSERT INTO %s (type, aggregateId, payload, occurredAt) VALUES (?, ?, ?, ?)`, o.tableName)
+	stmt, err := tx.PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	for _, evt := range events {
+		_, err = stmt.ExecContext(
+			ctx,
+			evt.Type,
+			evt.AggregateID,
+			string(evt.Payload),
+			evt.OccurredAt,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+	}
+
+	return
+}
+
+func (o *outboxImpl) Pending(ctx context.Context, limit int) (events []Event, err error) {
+	query := fmt.Sprintf(`SELECT id, type, aggregateId, payload, occurredAt, attempts FROM %s WHERE publishedAt IS NULL AND parkedAt IS NULL ORDER BY id LIMIT ?`, o.tableName)
+	stmt, err := database.Connection(ctx, o.db).PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, limit)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		evt := Event{}
+		var payload string
+
+		err = rows.Scan(
+			&evt.ID,
+			&evt.Type,
+			&evt.AggregateID,
+			&payload,
+			&evt.OccurredAt,
+			&evt.Attempts,
+		)
+
+		if err != nil {
+			log.Println(err)
+			err = exception.ErrInternalServer
+			return
+		}
+
+		evt.Payload = []byte(payload)
+		events = append(events, evt)
+	}
+
+	return
+}
+
+func (o *outboxImpl) MarkPublished(ctx context.Context, IDs []int64, at time.Time) (err error) {
+	if len(IDs) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(IDs)), ", ")
+	command := fmt.Sprintf(`UPDATE %s SET publishedAt = ? WHERE id IN (%s)`, o.tableName, placeholders)
+	stmt, err := database.Connection(ctx, o.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	args := make([]interface{}, 0, len(IDs)+1)
+	args = append(args, at)
+	for _, ID := range IDs {
+		args = append(args, ID)
+	}
+
+	_, err = stmt.ExecContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
+
+func (o *outboxImpl) MarkFailed(ctx context.Context, ID int64, lastError string, parkedAt *time.Time) (err error) {
+	if len(lastError) > maxErrorLength {
+		lastError = lastError[:maxErrorLength]
+	}
+
+	command := fmt.Sprintf(`UPDATE %s SET attempts = attempts + 1, lastError = ?, parkedAt = ? WHERE id = ?`, o.tableName)
+	stmt, err := database.Connection(ctx, o.db).PrepareContext(ctx, command)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, lastError, parkedAt, ID)
+	if err != nil {
+		log.Println(err)
+		err = exception.ErrInternalServer
+		return
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Handler reacts to a published event.
+type Handler func(ctx context.Context, evt Event) (err error)
+
+// InMemoryPublisher is an in-process bus, subscribers run synchronously in the relay goroutine.
+type InMemoryPublisher struct {
+	mu          sync.RWMutex
+	subscribers map[Type][]Handler
+}
+
+func NewInMemoryPublisher() *InMemoryPublisher {
+	return &InMemoryPublisher{
+		subscribers: make(map[Type][]Handler),
+	}
+}
+
+// Subscribe registers the handler for the event type.
+func (p *InMemoryPublisher) Subscribe(eventType Type, handler Handler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.subscribers[eventType] = append(p.subscribers[eventType], handler)
+}
+
+// Publish hands each event to its subscribers and stops at the first failure, the relay retries the event.
+func (p *InMemoryPublisher) Publish(ctx context.Context, events []Event) (err error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, evt := range events {
+		for _, handler := range p.subscribers[evt.Type] {
+			if err = handler(ctx, evt); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+type filePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFilePublisher appends every event as one JSON line to the file.
+func NewFilePublisher(path string) (publisher Publisher, err error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+
+	return &filePublisher{file: file}, nil
+}
+
+func (p *filePublisher) Publish(ctx context.Context, events []Event) (err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	encoder := json.NewEncoder(p.file)
+	for _, evt := range events {
+		if err = encoder.Encode(evt); err != nil {
+			return
+		}
+	}
+
+	return p.file.Sync()
+}
+
+type multiPublisher struct {
+	publishers []Publisher
+}
+
+// NewMultiPublisher publishes to every publisher in turn, a failure makes the relay retry all of them.
+func NewMultiPublisher(publishers ...Publisher) Publisher {
+	return &multiPublisher{publishers: publishers}
+}
+
+func (p *multiPublisher) Publish(ctx context.Context, events []Event) (err error) {
+	for _, publisher := range p.publishers {
+		if err = publisher.Publish(ctx, events); err != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Relay moves the outbox to the publisher. Delivery is at least once,
+// an event is marked only after the publisher accepted it.
+// An event that keeps failing is parked after maxAttempts, so it stops holding back the ones behind it.
+type Relay struct {
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	location    *time.Location
+	outbox      Outbox
+	publisher   Publisher
+}
+
+func NewRelay(interval time.Duration, batchSize int, maxAttempts int, location *time.Location, outbox Outbox, publisher Publisher) *Relay {
+	return &Relay{
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		location:    location,
+		outbox:      outbox,
+		publisher:   publisher,
+	}
+}
+
+// Run flushes the outbox on every tick until the context is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Flush(ctx); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// Flush publishes pending events one by one, in order, until the outbox is drained or an event fails.
+// A failed event is tried again on the next flush, or parked once it ran out of attempts.
+func (r *Relay) Flush(ctx context.Context) (published int, err error) {
+	for {
+		events, err := r.outbox.Pending(ctx, r.batchSize)
+		if err != nil || len(events) == 0 {
+			return published, err
+		}
+
+		for _, evt := range events {
+			err = r.publisher.Publish(ctx, []Event{evt})
+			if err != nil {
+				if err = r.fail(ctx, evt, err); err != nil {
+					return published, err
+				}
+				continue
+			}
+
+			err = r.outbox.MarkPublished(ctx, []int64{evt.ID}, time.Now().In(r.location))
+			if err != nil {
+				return published, err
+			}
+
+			published++
+		}
+
+		if len(events) < r.batchSize {
+			return published, nil
+		}
+	}
+}
+
+// fail counts the failed attempt. It returns the publish error while the event has attempts left,
+// which stops the flush so the events behind it keep their order.
+func (r *Relay) fail(ctx context.Context, evt Event, publishErr error) (err error) {
+	if evt.Attempts+1 < r.maxAttempts {
+		if err = r.outbox.MarkFailed(ctx, evt.ID, publishErr.Error(), nil); err != nil {
+			return
+		}
+		return publishErr
+	}
+
+	log.Printf("event %d %s parked after %d attempts: %v\n", evt.ID, evt.Type, evt.Attempts+1, publishErr)
+
+	now := time.Now().In(r.location)
+	return r.outbox.MarkFailed(ctx, evt.ID, publishErr.Error(), &now)
+}
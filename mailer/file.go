@@ -0,0 +1,47 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer writes every email as an .eml file in the directory instead of sending it, meant for development.
+func NewFileMailer(dir, from string) (Mailer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &fileMailer{dir: dir, from: from}, nil
+}
+
+func (m *fileMailer) Send(ctx context.Context, message Message) (err error) {
+	now := time.Now()
+
+	body, err := compose(m.from, message, now)
+	if err != nil {
+		return
+	}
+
+	recipient := strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(message.To)
+	path := filepath.Join(m.dir, fmt.Sprintf("%d-%s.eml", now.UnixNano(), recipient))
+
+	err = ioutil.WriteFile(path, body, 0644)
+	if err != nil {
+		return
+	}
+
+	log.Printf("mail to %s written to %s\n", message.To, path)
+
+	return
+}
@@ -0,0 +1,43 @@
+package mailer_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/mailer"
+)
+
+func TestFileMailerSend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mailer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fileMailer, err := mailer.NewFileMailer(dir, "Devoria <no-reply@devoria.id>")
+	assert.NoError(t, err)
+
+	err = fileMailer.Send(context.TODO(), mailer.Message{
+		To:      "jane.doe@email.com\r\nBcc: evil@email.com",
+		Subject: "Halo, artikel baru",
+		HTML:    "<p>Hi</p>",
+		Text:    "Hi",
+		Headers: map[string]string{"List-Unsubscribe": "<https://devoria.id/u>"},
+	})
+	assert.NoError(t, err)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+	assert.Len(t, files, 1)
+
+	raw, _ := ioutil.ReadFile(files[0])
+	message, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	assert.NoError(t, err)
+	assert.Empty(t, message.Header.Get("Bcc"))
+	assert.Equal(t, "<https://devoria.id/u>", message.Header.Get("List-Unsubscribe"))
+	assert.True(t, strings.HasPrefix(message.Header.Get("Content-Type"), "multipart/alternative"))
+}
@@ -0,0 +1,19 @@
+package mailer
+
+import (
+	"context"
+)
+
+// Message is an email with both an HTML and a plain text body.
+type Message struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+	Headers map[string]string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(ctx context.Context, message Message) (err error)
+}
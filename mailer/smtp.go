@@ -0,0 +1,37 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"time"
+)
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTP sends through an SMTP relay, the connection is upgraded with STARTTLS when the server offers it.
+func NewSMTP(host string, port int, username, password, from string) Mailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &smtpMailer{
+		addr: fmt.Sprintf("%s:%d", host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, message Message) (err error) {
+	body, err := compose(m.from, message, time.Now())
+	if err != nil {
+		return
+	}
+
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{message.To}, body)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -16,10 +17,17 @@ import (
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/sangianpatrick/devoria-article-service/config"
 	"github.com/sangianpatrick/devoria-article-service/crypto"
+	"github.com/sangianpatrick/devoria-article-service/database"
 	"github.com/sangianpatrick/devoria-article-service/domain/account"
 	"github.com/sangianpatrick/devoria-article-service/domain/article"
+	"github.com/sangianpatrick/devoria-article-service/domain/audit"
+	"github.com/sangianpatrick/devoria-article-service/domain/notification"
+	"github.com/sangianpatrick/devoria-article-service/domain/webhook"
+	"github.com/sangianpatrick/devoria-article-service/event"
 	"github.com/sangianpatrick/devoria-article-service/jwt"
+	"github.com/sangianpatrick/devoria-article-service/mailer"
 	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/moderation"
 	"github.com/sangianpatrick/devoria-article-service/session"
 	"go.elastic.co/apm/module/apmgoredisv8"
 	"go.elastic.co/apm/module/apmgorilla"
@@ -48,21 +56,133 @@ func main() {
 	rc.AddHook(apmgoredisv8.NewHook())
 
 	vld := validator.New()
+
+	wordlistModerator, err := moderation.NewWordlist(cfg.Moderation.BlockedWords, cfg.Moderation.FlaggedWords, cfg.Moderation.BlockedPattern, cfg.Moderation.FlaggedPattern)
+	if err != nil {
+		log.Fatal(err)
+	}
+	contentModerator := moderation.NewChain(
+		wordlistModerator,
+		moderation.NewSpamLink(cfg.Moderation.FlagLinksAbove, cfg.Moderation.BlockLinksAbove, cfg.Moderation.BlockedDomains),
+	)
 	encryption := crypto.NewAES256CBC(cfg.AES.SecretKey)
+	passwordHasher, err := crypto.NewPasswordHasher(crypto.PasswordAlgorithm(cfg.Password.Algorithm), crypto.DefaultArgon2idParams, cfg.Password.BcryptCost)
+	if err != nil {
+		log.Fatal(err)
+	}
 	jsonWebToken := jwt.NewJSONWebToken(jwt.GetRSAPrivateKey("./secret/id_rsa"), jwt.GetRSAPublicKey("./secret/id_rsa.pub"))
 	sess := session.NewRedisSessionStoreAdapter(rc, time.Hour*24*1)
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.App.TrustedProxies)
+	if err != nil {
+		log.Fatal(err)
+	}
 	basicAuthMiddleware := middleware.NewBasicAuth(cfg.BasicAuth.Username, cfg.BasicAuth.Password)
 
 	router := mux.NewRouter()
 	apmgorilla.Instrument(router)
+	router.Use(middleware.RequestInformation(trustedProxies))
 
-	accountRepository := account.NewAccountRepository(db, "account")
-	articleRepository := article.NewArticleRepository(db, "article")
-	accountUsecase := account.NewAccountUsecase(cfg.GlobalIV, sess, jsonWebToken, encryption, location, accountRepository)
-	articleUsecase := article.NewArticleUsecase(cfg.GlobalIV, sess, jsonWebToken, encryption, location, articleRepository, accountRepository)
-	bearerAuthMiddleware := middleware.NewBearerAuth(jsonWebToken)
+	eventOutbox := event.NewOutbox(db, "event_outbox")
+	eventPublisher := event.NewInMemoryPublisher()
+	accountRepository := account.NewAccountRepository(db, "account", eventOutbox)
+	relatedArticleIndex := article.NewRelatedArticleIndex()
+	articleRepository := article.NewCachedArticleRepository(article.NewIndexedArticleRepository(article.NewArticleRepository(db, "article", eventOutbox), relatedArticleIndex), rc, time.Minute*5, "article:cache")
+	previewLinkRepository := article.NewPreviewLinkRepository(db, "article_preview_link", "article_preview_view")
+	reviewRepository := article.NewReviewRepository(db, "article_review", "article_review_note", "article_review_decision")
+	translationRepository := article.NewArticleTranslationRepository(db, "article_translation")
+	duplicateRepository := article.NewDuplicateRepository(db, "article_fingerprint", "article_duplicate")
+	duplicateDetector := article.NewDuplicateDetector(location, duplicateRepository)
+	featuredRepository := article.NewFeaturedRepository(db, "article_featured")
+	engagementRepository := article.NewEngagementRepository(db, "article_engagement", "article")
+	trendingStore := article.NewRedisTrendingStore(rc, "article:trending")
+	viewCounter := article.NewViewCounter(article.ArticleViewWindow, 1024, article.NewRedisViewThrottle(rc, "article:viewed"), engagementRepository, accountRepository)
+	articleLinkRepository := article.NewArticleLinkRepository(db, "article_link", "article")
+	linkGraph := article.NewLinkGraph(cfg.App.BaseURL, articleLinkRepository)
+	webhookRepository := webhook.NewWebhookRepository(db, "webhook", "webhook_delivery", "account")
+	notificationRepository := notification.NewNotificationRepository(db, "account_follower", "notification_preference", "notification_email", "account")
+	auditRepository := audit.NewAuditRepository(db, "audit_log")
+	auditRecorder := audit.NewRecorder(location, database.NewTransactor(db), auditRepository, accountRepository)
+	accountUsecase := audit.NewAuditedAccountUsecase(account.NewAccountUsecase(cfg.GlobalIV, sess, jsonWebToken, encryption, passwordHasher, location, accountRepository), auditRecorder)
+	articleStateMachine := article.NewArticleStateMachine()
+	article.NewReviewWorkflow(reviewRepository).Register(articleStateMachine)
+	moderationWorkflow := article.NewModerationWorkflow(contentModerator)
+	moderationWorkflow.Register(articleStateMachine)
+	duplicateDetector.Register(articleStateMachine)
+	linkGraph.Register(articleStateMachine)
+	article.NewMentionWorkflow(cfg.App.BaseURL, accountRepository).Register(articleStateMachine)
+	articleUsecase := audit.NewAuditedArticleUsecase(article.NewArticleUsecase(cfg.GlobalIV, sess, jsonWebToken, encryption, location, articleRepository, accountRepository, articleStateMachine, translationRepository, duplicateDetector, viewCounter, linkGraph, moderationWorkflow), auditRecorder, articleRepository)
+	previewLinkUsecase := audit.NewAuditedPreviewLinkUsecase(article.NewPreviewLinkUsecase(jsonWebToken, location, previewLinkRepository, articleRepository, accountRepository), auditRecorder)
+	reviewUsecase := audit.NewAuditedReviewUsecase(article.NewReviewUsecase(location, reviewRepository, articleRepository, accountRepository, articleStateMachine), auditRecorder, reviewRepository)
+	relatedArticleUsecase := article.NewRelatedArticleUsecase(relatedArticleIndex, articleRepository)
+	translationUsecase := audit.NewAuditedTranslationUsecase(article.NewTranslationUsecase(location, translationRepository, articleRepository, accountRepository, moderationWorkflow), auditRecorder, translationRepository)
+	duplicateUsecase := article.NewDuplicateUsecase(duplicateRepository, accountRepository)
+	featuredUsecase := audit.NewAuditedFeaturedUsecase(article.NewFeaturedUsecase(location, featuredRepository, articleRepository, accountRepository), auditRecorder, featuredRepository)
+	trendingUsecase := article.NewTrendingUsecase(trendingStore, articleRepository)
+	backlinkUsecase := article.NewBacklinkUsecase(articleRepository, articleLinkRepository)
+	analysisUsecase := article.NewAnalysisUsecase(articleRepository, accountRepository)
+	webhookUsecase := audit.NewAuditedWebhookUsecase(webhook.NewWebhookUsecase(location, net.DefaultResolver, webhookRepository, accountRepository), auditRecorder, webhookRepository)
+	notificationUsecase := notification.NewNotificationUsecase(location, jsonWebToken, notificationRepository, accountRepository)
+	auditUsecase := audit.NewAuditUsecase(auditRepository, accountRepository)
+	bearerAuthMiddleware := middleware.NewBearerAuth(jsonWebToken, sess)
 	account.NewAccountHTTPHandler(router, basicAuthMiddleware, bearerAuthMiddleware, vld, accountUsecase)
 	article.NewArticleHTTPHandler(router, basicAuthMiddleware, bearerAuthMiddleware, vld, articleUsecase)
+	article.NewPreviewLinkHTTPHandler(router, bearerAuthMiddleware, vld, previewLinkUsecase)
+	article.NewReviewHTTPHandler(router, bearerAuthMiddleware, vld, reviewUsecase)
+	article.NewTranslationHTTPHandler(router, bearerAuthMiddleware, vld, translationUsecase)
+	article.NewRelatedArticleHTTPHandler(router, basicAuthMiddleware, bearerAuthMiddleware, vld, relatedArticleUsecase)
+	article.NewDuplicateHTTPHandler(router, bearerAuthMiddleware, duplicateUsecase)
+	article.NewFeaturedHTTPHandler(router, basicAuthMiddleware, bearerAuthMiddleware, vld, featuredUsecase)
+	article.NewTrendingHTTPHandler(router, basicAuthMiddleware, bearerAuthMiddleware, vld, trendingUsecase)
+	article.NewBacklinkHTTPHandler(router, basicAuthMiddleware, bearerAuthMiddleware, vld, backlinkUsecase)
+	article.NewAnalysisHTTPHandler(router, bearerAuthMiddleware, vld, analysisUsecase)
+	webhook.NewWebhookHTTPHandler(router, bearerAuthMiddleware, vld, webhookUsecase)
+	notification.NewNotificationHTTPHandler(router, bearerAuthMiddleware, vld, notificationUsecase)
+	audit.NewAuditHTTPHandler(router, bearerAuthMiddleware, vld, auditUsecase)
+
+	err = relatedArticleIndex.Build(context.Background(), articleRepository)
+	if err != nil {
+		log.Println(err)
+	}
+
+	articleScheduler := article.NewArticleScheduler(time.Minute, location, articleStateMachine, articleRepository, accountRepository)
+	backgroundCtx, stopBackgroundJobs := context.WithCancel(context.Background())
+	go articleScheduler.Run(backgroundCtx)
+
+	go viewCounter.Run(backgroundCtx)
+
+	trendingJob := article.NewTrendingJob(time.Minute*5, location, engagementRepository, trendingStore)
+	go trendingJob.Run(backgroundCtx)
+
+	webhookDispatcher := webhook.NewDispatcher(time.Second*10, 8, time.Second*30, location, webhook.NewClient(time.Second*10), webhookRepository)
+	webhookDispatcher.Subscribe(eventPublisher)
+	go webhookDispatcher.Run(backgroundCtx)
+
+	var mail mailer.Mailer
+	switch cfg.Mail.Driver {
+	case "smtp":
+		mail = mailer.NewSMTP(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	default:
+		mail, err = mailer.NewFileMailer(cfg.Mail.Dir, cfg.Mail.From)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	notifier := notification.NewNotifier(time.Second*10, 5, cfg.Notification.BaseURL, location, jsonWebToken, mail, notificationRepository, articleRepository, accountRepository)
+	notifier.Subscribe(eventPublisher)
+	go notifier.Run(backgroundCtx)
+
+	var relayPublisher event.Publisher = eventPublisher
+	if cfg.Event.LogPath != "" {
+		filePublisher, err := event.NewFilePublisher(cfg.Event.LogPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		relayPublisher = event.NewMultiPublisher(eventPublisher, filePublisher)
+	}
+
+	eventRelay := event.NewRelay(time.Second*5, 100, 5, location, eventOutbox, relayPublisher)
+	go eventRelay.Run(backgroundCtx)
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf("127.0.0.1:%s", cfg.App.Port),
@@ -79,6 +199,7 @@ func main() {
 
 	fmt.Println("shutting down application ...")
 
+	stopBackgroundJobs()
 	server.Shutdown(context.Background())
 	db.Close()
 	rc.Close()
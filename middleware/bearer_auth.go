@@ -2,42 +2,87 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
 	"github.com/sangianpatrick/devoria-article-service/jwt"
+	"github.com/sangianpatrick/devoria-article-service/session"
 )
 
 // BearerAuth is a concrete struct of bearer auth verifier.
 type BearerAuth struct {
 	jsonWebToken jwt.JSONWebToken
+	session      session.Session
 }
 
 // NewBearer is a constructor.
-func NewBearerAuth(jsonWebToken jwt.JSONWebToken) RouteMiddlewareBearer {
-	return &BearerAuth{jsonWebToken}
+func NewBearerAuth(jsonWebToken jwt.JSONWebToken, session session.Session) RouteMiddlewareBearer {
+	return &BearerAuth{jsonWebToken, session}
 }
 
 // Verify will verify the request to ensure it comes with an authorized bearer auth token.
 func (b *BearerAuth) VerifyBearer(next http.HandlerFunc) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-		auth := r.Header.Get("Authorization")
-		strArr := strings.Split(auth, " ")
-		if len(strArr) != 2 {
-			http.Error(w, "unauthorized", http.StatusUnauthorized)
-			return
-		 }
-		 token := strArr[1]
-		res, err := b.jsonWebToken.Parse(ctx, token, &entity.AccountStandardJWTClaims{})
-		if err != nil {
+		ctx, ok := b.authenticate(r)
+		if !ok {
 			http.Error(w, "unauthorized", http.StatusUnauthorized)
 			return
 		}
-		claims := (res.Claims).(*entity.AccountStandardJWTClaims)
-		ctx = context.WithValue(ctx, entity.EmailCtx, claims.Email)
 
 		next(w, r.WithContext(ctx))
 	})
 }
+
+// VerifyBearerOrFallback lets public routes recognize a signed in account.
+// Requests with a bearer token must carry a valid one, every other request is verified by the fallback.
+func (b *BearerAuth) VerifyBearerOrFallback(fallback RouteMiddleware, next http.HandlerFunc) http.HandlerFunc {
+	verifyFallback := fallback.Verify(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			verifyFallback(w, r)
+			return
+		}
+
+		b.VerifyBearer(next)(w, r)
+	})
+}
+
+func (b *BearerAuth) authenticate(r *http.Request) (ctx context.Context, ok bool) {
+	ctx = r.Context()
+	auth := r.Header.Get("Authorization")
+	strArr := strings.Split(auth, " ")
+	if len(strArr) != 2 {
+		return ctx, false
+	}
+	token := strArr[1]
+	res, err := b.jsonWebToken.Parse(ctx, token, &entity.AccountStandardJWTClaims{})
+	if err != nil {
+		return ctx, false
+	}
+	claims := (res.Claims).(*entity.AccountStandardJWTClaims)
+	if !b.active(ctx, claims) {
+		return ctx, false
+	}
+	ctx = context.WithValue(ctx, entity.EmailCtx, claims.Email)
+	ctx = context.WithValue(ctx, entity.SessionIDCtx, claims.Id)
+
+	return ctx, true
+}
+
+// active reports whether the session of the token is still kept, a logged out token is signed but no longer valid.
+func (b *BearerAuth) active(ctx context.Context, claims *entity.AccountStandardJWTClaims) bool {
+	if claims.Id == "" {
+		return false
+	}
+
+	expiry, err := b.session.GetField(ctx, fmt.Sprintf(entity.AccountSessionKeyFormat, claims.Email), claims.Id)
+	if err != nil {
+		return false
+	}
+
+	return entity.SessionActive(expiry, time.Now())
+}
@@ -0,0 +1,77 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	jsonWebTokenMocks "github.com/sangianpatrick/devoria-article-service/jwt/mocks"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/session"
+	sessionMocks "github.com/sangianpatrick/devoria-article-service/session/mocks"
+)
+
+func verifyBearer(sess *sessionMocks.Session, sessionID string) (statusCode int, signedInSessionID string) {
+	claims := &entity.AccountStandardJWTClaims{Email: "john.doe@email.com"}
+	claims.Id = sessionID
+
+	jsonWebToken := new(jsonWebTokenMocks.JSONWebToken)
+	jsonWebToken.On("Parse", mock.Anything, "token", mock.Anything).Return(&jwtgo.Token{Claims: claims, Valid: true}, nil)
+
+	handler := middleware.NewBearerAuth(jsonWebToken, sess).VerifyBearer(func(w http.ResponseWriter, r *http.Request) {
+		signedInSessionID, _ = r.Context().Value(entity.SessionIDCtx).(string)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/accounts/profile", nil)
+	r.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+
+	handler(recorder, r)
+
+	return recorder.Code, signedInSessionID
+}
+
+func activeSession() *sessionMocks.Session {
+	sess := new(sessionMocks.Session)
+	sess.On("GetField", mock.Anything, "account:sessions:john.doe@email.com", "this-device").Return(entity.FormatSessionExpiry(time.Now().Add(time.Hour).Unix()), nil)
+	sess.On("GetField", mock.Anything, "account:sessions:john.doe@email.com", "other-device").Return(nil, session.ErrSessionNotFound)
+
+	return sess
+}
+
+func TestBearerAuthVerifyBearer_ActiveSession(t *testing.T) {
+	statusCode, sessionID := verifyBearer(activeSession(), "this-device")
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "this-device", sessionID)
+}
+
+func TestBearerAuthVerifyBearer_LoggedOutDevice(t *testing.T) {
+	statusCode, _ := verifyBearer(activeSession(), "other-device")
+
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+}
+
+func TestBearerAuthVerifyBearer_TokenWithoutSessionID(t *testing.T) {
+	sess := activeSession()
+	statusCode, _ := verifyBearer(sess, "")
+
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+	sess.AssertNotCalled(t, "GetField", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBearerAuthVerifyBearer_ExpiredSession(t *testing.T) {
+	sess := new(sessionMocks.Session)
+	sess.On("GetField", mock.Anything, "account:sessions:john.doe@email.com", "this-device").Return(entity.FormatSessionExpiry(time.Now().Add(-time.Minute).Unix()), nil)
+
+	statusCode, _ := verifyBearer(sess, "this-device")
+
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+}
@@ -0,0 +1,100 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/domain/account/entity"
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+	"github.com/sangianpatrick/devoria-article-service/response"
+)
+
+var lastModified = time.Date(2021, time.October, 1, 8, 0, 0, 0, time.UTC)
+
+func articleHandler(w http.ResponseWriter, r *http.Request) {
+	response.WithLastModified(response.Success(response.StatusOK, "article"), lastModified).JSON(w)
+}
+
+func TestConditional_FullResponse(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	recorder := httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(recorder, r)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotEmpty(t, recorder.Header().Get("ETag"))
+	assert.Equal(t, lastModified.Format(http.TimeFormat), recorder.Header().Get("Last-Modified"))
+	assert.Equal(t, middleware.CacheControlPublic, recorder.Header().Get("Cache-Control"))
+	assert.NotEmpty(t, recorder.Body.String())
+}
+
+func TestConditional_IfNoneMatch(t *testing.T) {
+	first := httptest.NewRecorder()
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(first, httptest.NewRequest(http.MethodGet, "/v1/article/1", nil))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	r.Header.Set("If-None-Match", first.Header().Get("ETag"))
+	recorder := httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(recorder, r)
+
+	assert.Equal(t, http.StatusNotModified, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestConditional_IfNoneMatchWinsOverIfModifiedSince(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	r.Header.Set("If-None-Match", `"stale"`)
+	r.Header.Set("If-Modified-Since", lastModified.Format(http.TimeFormat))
+	recorder := httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(recorder, r)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestConditional_IfModifiedSince(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	r.Header.Set("If-Modified-Since", lastModified.Add(time.Minute).Format(http.TimeFormat))
+	recorder := httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(recorder, r)
+
+	assert.Equal(t, http.StatusNotModified, recorder.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	r.Header.Set("If-Modified-Since", lastModified.Add(-time.Minute).Format(http.TimeFormat))
+	recorder = httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(recorder, r)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestConditional_SignedInIsPrivate(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	r = r.WithContext(context.WithValue(r.Context(), entity.EmailCtx, "author@devoria.id"))
+	recorder := httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, articleHandler)(recorder, r)
+
+	assert.Equal(t, middleware.CacheControlPrivate, recorder.Header().Get("Cache-Control"))
+}
+
+func TestConditional_ErrorIsNotCached(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v1/article/1", nil)
+	recorder := httptest.NewRecorder()
+
+	middleware.Conditional(middleware.CacheControlPublic, func(w http.ResponseWriter, r *http.Request) {
+		response.Error(response.StatusNotFound, nil, nil).JSON(w)
+	})(recorder, r)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, middleware.CacheControlNoStore, recorder.Header().Get("Cache-Control"))
+	assert.Empty(t, recorder.Header().Get("ETag"))
+}
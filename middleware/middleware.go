@@ -9,4 +9,5 @@ type RouteMiddleware interface {
 
 type RouteMiddlewareBearer interface {
 	VerifyBearer(next http.HandlerFunc) http.HandlerFunc
+	VerifyBearerOrFallback(fallback RouteMiddleware, next http.HandlerFunc) http.HandlerFunc
 }
\ No newline at end of file
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+type requestInfoContextKey struct{}
+
+// HeaderRequestID carries the id of the request, it is taken from the client when sane and echoed back.
+const HeaderRequestID = "X-Request-ID"
+
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestInfo describes who sent a request.
+type RequestInfo struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+// WithRequestInfo puts the request information in the context.
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoContextKey{}, info)
+}
+
+// RequestInfoFromContext returns the request information, empty outside of a request.
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoContextKey{}).(RequestInfo)
+	return info
+}
+
+// ParseTrustedProxies reads the addresses of the proxies in front of the service, as IPs or CIDR ranges.
+func ParseTrustedProxies(proxies []string) (networks []*net.IPNet, err error) {
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+		}
+		networks = append(networks, network)
+	}
+
+	return
+}
+
+// RequestInformation records the client address, user agent and request id of every request.
+// X-Forwarded-For is only read when the request comes from a trusted proxy, the client may send the header too.
+func RequestInformation(trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(HeaderRequestID)
+			if !requestIDPattern.MatchString(requestID) {
+				requestID = newRequestID()
+			}
+			w.Header().Set(HeaderRequestID, requestID)
+
+			info := RequestInfo{
+				IP:        clientIP(r, trustedProxies),
+				UserAgent: r.UserAgent(),
+				RequestID: requestID,
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithRequestInfo(r.Context(), info)))
+		})
+	}
+}
+
+// clientIP is the remote address, unless it is a trusted proxy. Proxies append the address they received
+// the request from, so X-Forwarded-For is read from the right, skipping the trusted proxies,
+// and the first other address is the client. Addresses left of it may have been made up by the client.
+func clientIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	remote, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remote = r.RemoteAddr
+	}
+
+	if !trusted(remote, trustedProxies) {
+		return remote
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+		remote = ip
+		if !trusted(ip, trustedProxies) {
+			break
+		}
+	}
+
+	return remote
+}
+
+func trusted(address string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
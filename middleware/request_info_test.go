@@ -0,0 +1,62 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/middleware"
+)
+
+func recordedIP(t *testing.T, trustedProxies []string, remoteAddr string, forwardedFor ...string) (ip string) {
+	networks, err := middleware.ParseTrustedProxies(trustedProxies)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := middleware.RequestInformation(networks)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip = middleware.RequestInfoFromContext(r.Context()).IP
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/article", nil)
+	r.RemoteAddr = remoteAddr
+	for _, value := range forwardedFor {
+		r.Header.Add("X-Forwarded-For", value)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	return
+}
+
+func TestRequestInformation_NoTrustedProxy(t *testing.T) {
+	ip := recordedIP(t, nil, "198.51.100.7:52000", "203.0.113.1")
+
+	assert.Equal(t, "198.51.100.7", ip, "the header should be ignored without a trusted proxy")
+}
+
+func TestRequestInformation_ForgedForwardedFor(t *testing.T) {
+	//The client sent its own X-Forwarded-For, the proxy appended the address it saw
+	ip := recordedIP(t, []string{"127.0.0.1"}, "127.0.0.1:52000", "203.0.113.1, 198.51.100.7")
+
+	assert.Equal(t, "198.51.100.7", ip)
+}
+
+func TestRequestInformation_ProxyChain(t *testing.T) {
+	ip := recordedIP(t, []string{"127.0.0.1", "10.0.0.0/8"}, "127.0.0.1:52000", "198.51.100.7", "10.0.0.3")
+
+	assert.Equal(t, "198.51.100.7", ip)
+}
+
+func TestRequestInformation_UntrustedRemoteAddr(t *testing.T) {
+	ip := recordedIP(t, []string{"127.0.0.1"}, "198.51.100.7:52000", "127.0.0.1")
+
+	assert.Equal(t, "198.51.100.7", ip)
+}
+
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	_, err := middleware.ParseTrustedProxies([]string{"proxy.local"})
+
+	assert.Error(t, err)
+}
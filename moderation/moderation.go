@@ -0,0 +1,87 @@
+package moderation
+
+import (
+	"context"
+)
+
+// Verdict is the outcome of a moderation check.
+type Verdict string
+
+const (
+	VerdictAllow Verdict = "ALLOW"
+	VerdictFlag  Verdict = "FLAG"
+	VerdictBlock Verdict = "BLOCK"
+)
+
+var verdictSeverity = map[Verdict]int{
+	VerdictAllow: 0,
+	VerdictFlag:  1,
+	VerdictBlock: 2,
+}
+
+// Content is the text submitted for moderation, e.g. an article or a comment.
+type Content struct {
+	Kind string
+	Text []string
+}
+
+// Reason explains why a check flagged or blocked the content.
+type Reason struct {
+	Check   string  `json:"check"`
+	Verdict Verdict `json:"verdict"`
+	Rule    string  `json:"rule"`
+	Match   string  `json:"match,omitempty"`
+}
+
+// Result is the verdict of a check with the reasons behind it.
+type Result struct {
+	Verdict Verdict  `json:"verdict"`
+	Reasons []Reason `json:"reasons,omitempty"`
+}
+
+// Add records a reason and raises the verdict when the reason is more severe.
+func (r *Result) Add(reason Reason) {
+	r.Reasons = append(r.Reasons, reason)
+	if verdictSeverity[reason.Verdict] > verdictSeverity[r.Verdict] {
+		r.Verdict = reason.Verdict
+	}
+}
+
+// ContentModerator is a collection behavior of content moderation.
+type ContentModerator interface {
+	Moderate(ctx context.Context, content Content) (result Result, err error)
+}
+
+// Chain runs several checks, the most severe verdict wins.
+type Chain struct {
+	moderators []ContentModerator
+}
+
+// NewChain is constructor.
+func NewChain(moderators ...ContentModerator) ContentModerator {
+	return &Chain{
+		moderators: moderators,
+	}
+}
+
+// Moderate returns the combined result, it stops at the first check that blocks.
+func (c *Chain) Moderate(ctx context.Context, content Content) (result Result, err error) {
+	result.Verdict = VerdictAllow
+
+	for _, moderator := range c.moderators {
+		checked, err := moderator.Moderate(ctx, content)
+		if err != nil {
+			return result, err
+		}
+
+		for _, reason := range checked.Reasons {
+			result.Add(reason)
+		}
+
+		if result.Verdict == VerdictBlock {
+			break
+		}
+	}
+
+	return
+}
@@ -0,0 +1,59 @@
+package moderation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sangianpatrick/devoria-article-service/moderation"
+)
+
+func TestWordlist_Block(t *testing.T) {
+	wordlist, err := moderation.NewWordlist([]string{"casino"}, []string{"cheap"}, "", "")
+	assert.NoError(t, err)
+
+	result, err := wordlist.Moderate(context.TODO(), moderation.Content{Text: []string{"Best CASINO bonus"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, moderation.VerdictBlock, result.Verdict)
+	assert.Equal(t, "CASINO", result.Reasons[0].Match)
+}
+
+func TestWordlist_WholeWordsOnly(t *testing.T) {
+	wordlist, err := moderation.NewWordlist([]string{"ass"}, nil, "", "")
+	assert.NoError(t, err)
+
+	result, err := wordlist.Moderate(context.TODO(), moderation.Content{Text: []string{"a classic assessment"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, moderation.VerdictAllow, result.Verdict)
+}
+
+func TestWordlist_InvalidPattern(t *testing.T) {
+	_, err := moderation.NewWordlist(nil, nil, "(", "")
+
+	assert.Error(t, err)
+}
+
+func TestSpamLink_BlockedDomain(t *testing.T) {
+	spamLink := moderation.NewSpamLink(5, 20, []string{"spam.example"})
+
+	result, err := spamLink.Moderate(context.TODO(), moderation.Content{Text: []string{"visit https://win.spam.example/now"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, moderation.VerdictBlock, result.Verdict)
+}
+
+func TestChain_MostSevereVerdictWins(t *testing.T) {
+	wordlist, _ := moderation.NewWordlist(nil, []string{"cheap"}, "", "")
+	chain := moderation.NewChain(wordlist, moderation.NewSpamLink(0, 2, nil))
+
+	result, err := chain.Moderate(context.TODO(), moderation.Content{Text: []string{
+		"cheap deals at http://a.example http://b.example http://c.example",
+	}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, moderation.VerdictBlock, result.Verdict)
+	assert.Len(t, result.Reasons, 2)
+}
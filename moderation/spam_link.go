@@ -0,0 +1,99 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const spamLinkCheck = "spam_link"
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"'()]+`)
+
+// SpamLink catches link farms by counting the links and checking their domains.
+type SpamLink struct {
+	flagAbove      int
+	blockAbove     int
+	blockedDomains map[string]bool
+}
+
+// NewSpamLink is constructor. A threshold of zero disables it.
+func NewSpamLink(flagAbove, blockAbove int, blockedDomains []string) ContentModerator {
+	spamLink := &SpamLink{
+		flagAbove:      flagAbove,
+		blockAbove:     blockAbove,
+		blockedDomains: make(map[string]bool),
+	}
+
+	for _, domain := range blockedDomains {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			spamLink.blockedDomains[domain] = true
+		}
+	}
+
+	return spamLink
+}
+
+// Moderate counts every link of the content and blocks those pointing at a blocked domain or its subdomains.
+func (s *SpamLink) Moderate(ctx context.Context, content Content) (result Result, err error) {
+	result.Verdict = VerdictAllow
+
+	var links int
+	for _, text := range content.Text {
+		for _, link := range linkPattern.FindAllString(text, -1) {
+			links++
+
+			parsed, err := url.Parse(link)
+			if err != nil {
+				continue
+			}
+
+			if domain, blocked := s.blockedDomain(parsed.Hostname()); blocked {
+				result.Add(Reason{
+					Check:   spamLinkCheck,
+					Verdict: VerdictBlock,
+					Rule:    "blocked domain " + domain,
+					Match:   link,
+				})
+			}
+		}
+	}
+
+	switch {
+	case s.blockAbove > 0 && links > s.blockAbove:
+		result.Add(Reason{
+			Check:   spamLinkCheck,
+			Verdict: VerdictBlock,
+			Rule:    fmt.Sprintf("more than %d links", s.blockAbove),
+			Match:   fmt.Sprintf("%d links", links),
+		})
+	case s.flagAbove > 0 && links > s.flagAbove:
+		result.Add(Reason{
+			Check:   spamLinkCheck,
+			Verdict: VerdictFlag,
+			Rule:    fmt.Sprintf("more than %d links", s.flagAbove),
+			Match:   fmt.Sprintf("%d links", links),
+		})
+	}
+
+	return
+}
+
+func (s *SpamLink) blockedDomain(host string) (domain string, blocked bool) {
+	host = strings.ToLower(host)
+	for host != "" {
+		if s.blockedDomains[host] {
+			return host, true
+		}
+
+		i := strings.Index(host, ".")
+		if i < 0 {
+			break
+		}
+		host = host[i+1:]
+	}
+
+	return
+}
@@ -0,0 +1,89 @@
+package moderation
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+const wordlistCheck = "wordlist"
+
+// Wordlist blocks or flags content containing listed words or matching patterns.
+type Wordlist struct {
+	block []*regexp.Regexp
+	flag  []*regexp.Regexp
+}
+
+// NewWordlist is constructor. Words are matched whole and case insensitive, patterns are regular expressions.
+func NewWordlist(blockedWords, flaggedWords []string, blockedPattern, flaggedPattern string) (moderator ContentModerator, err error) {
+	wordlist := &Wordlist{}
+
+	wordlist.block, err = compileRules(blockedWords, blockedPattern)
+	if err != nil {
+		return
+	}
+
+	wordlist.flag, err = compileRules(flaggedWords, flaggedPattern)
+	if err != nil {
+		return
+	}
+
+	return wordlist, nil
+}
+
+func compileRules(words []string, pattern string) (rules []*regexp.Regexp, err error) {
+	var quoted []string
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+
+	if len(quoted) > 0 {
+		rule, err := regexp.Compile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if pattern != "" {
+		rule, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return
+}
+
+// Moderate checks the blocking rules first, a flag is only reported when nothing blocks.
+func (w *Wordlist) Moderate(ctx context.Context, content Content) (result Result, err error) {
+	result.Verdict = VerdictAllow
+
+	for _, rules := range []struct {
+		verdict Verdict
+		rules   []*regexp.Regexp
+	}{{VerdictBlock, w.block}, {VerdictFlag, w.flag}} {
+		for _, rule := range rules.rules {
+			for _, text := range content.Text {
+				if match := rule.FindString(text); match != "" {
+					result.Add(Reason{
+						Check:   wordlistCheck,
+						Verdict: rules.verdict,
+						Rule:    rule.String(),
+						Match:   match,
+					})
+					break
+				}
+			}
+		}
+
+		if result.Verdict != VerdictAllow {
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,11 @@
+package response
+
+// Data returns what the response carries, decorators use it to look at the result of a usecase.
+func Data(resp Response) interface{} {
+	impl := unwrap(resp)
+	if impl == nil {
+		return nil
+	}
+
+	return impl.Data
+}
@@ -0,0 +1,160 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// RequestedFields reads the sparse fieldset of `?fields=id,title,publishedAt`.
+func RequestedFields(r *http.Request) (fields []string) {
+	for _, value := range r.URL.Query()["fields"] {
+		for _, field := range strings.Split(value, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	return
+}
+
+// UnknownFieldError is returned when a sparse fieldset names a property the data does not have.
+type UnknownFieldError struct {
+	Fields []string `json:"unknownFields"`
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// WithFields trims a successful response down to the requested JSON properties.
+// The allowed properties are the JSON tags of the data type, so a property left out by `omitempty` can still be asked for.
+func WithFields(resp Response, fields []string) Response {
+	if len(fields) == 0 || resp.Err() != nil {
+		return resp
+	}
+
+	impl := unwrap(resp)
+	if impl == nil || impl.Data == nil {
+		return resp
+	}
+
+	data, err := selectFields(impl.Data, fields)
+	if err != nil {
+		return Error(StatusInvalidPayload, err, err)
+	}
+
+	impl.Data = data
+
+	return resp
+}
+
+func unwrap(resp Response) *responseImpl {
+	for {
+		switch r := resp.(type) {
+		case *responseImpl:
+			return r
+		case *lastModifiedResponse:
+			resp = r.Response
+		default:
+			return nil
+		}
+	}
+}
+
+func selectFields(data interface{}, fields []string) (selected interface{}, err error) {
+	known := jsonFields(reflect.TypeOf(data))
+
+	var unknown []string
+	for _, field := range fields {
+		if !known[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, &UnknownFieldError{Fields: unknown}
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(encoded, &selected)
+	if err != nil {
+		return
+	}
+
+	switch value := selected.(type) {
+	case map[string]interface{}:
+		return pick(value, fields), nil
+	case []interface{}:
+		for i, element := range value {
+			if object, ok := element.(map[string]interface{}); ok {
+				value[i] = pick(object, fields)
+			}
+		}
+		return value, nil
+	}
+
+	return
+}
+
+func pick(object map[string]interface{}, fields []string) map[string]interface{} {
+	picked := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if value, ok := object[field]; ok {
+			picked[field] = value
+		}
+	}
+
+	return picked
+}
+
+// jsonFields lists the JSON property names of a struct, a pointer to one or a slice of them.
+// Embedded structs are flattened the same way encoding/json does.
+func jsonFields(t reflect.Type) (fields map[string]bool) {
+	fields = make(map[string]bool)
+	if t == nil {
+		return
+	}
+
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			for embedded := range jsonFields(field.Type) {
+				fields[embedded] = true
+			}
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+	}
+
+	return
+}
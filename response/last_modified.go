@@ -0,0 +1,26 @@
+package response
+
+import (
+	"net/http"
+	"time"
+)
+
+type lastModifiedResponse struct {
+	Response
+	lastModified time.Time
+}
+
+// WithLastModified makes the response announce when its data last changed, so clients can revalidate it.
+func WithLastModified(resp Response, lastModified time.Time) Response {
+	return &lastModifiedResponse{
+		Response:     resp,
+		lastModified: lastModified,
+	}
+}
+
+func (r *lastModifiedResponse) JSON(w http.ResponseWriter) (err error) {
+	if !r.lastModified.IsZero() {
+		w.Header().Set("Last-Modified", r.lastModified.UTC().Format(http.TimeFormat))
+	}
+	return r.Response.JSON(w)
+}
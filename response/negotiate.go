@@ -0,0 +1,134 @@
+package response
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnrenderable tells the negotiator a renderer cannot represent the data, the response falls back to JSON.
+var ErrUnrenderable = errors.New("data cannot be rendered in this format")
+
+// Renderer writes the data of a successful response in a format other than JSON.
+type Renderer interface {
+	ContentType() string
+	Render(w io.Writer, data interface{}) (err error)
+}
+
+// Negotiator writes a response in the format the client prefers according to its `Accept` header.
+// JSON stays the default, a nil negotiator always writes JSON.
+type Negotiator struct {
+	renderers []Renderer
+}
+
+func NewNegotiator(renderers ...Renderer) *Negotiator {
+	return &Negotiator{renderers: renderers}
+}
+
+// Write renders successful responses with the preferred renderer, errors are always written as JSON.
+func (n *Negotiator) Write(w http.ResponseWriter, r *http.Request, resp Response) (err error) {
+	if n == nil || resp.Err() != nil {
+		return resp.JSON(w)
+	}
+
+	impl := unwrap(resp)
+	if impl == nil {
+		return resp.JSON(w)
+	}
+
+	renderer, acceptable := n.choose(r.Header.Get("Accept"))
+	if !acceptable {
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+	if renderer == nil {
+		return resp.JSON(w)
+	}
+
+	var body bytes.Buffer
+	err = renderer.Render(&body, impl.Data)
+	if err == ErrUnrenderable {
+		return resp.JSON(w)
+	}
+	if err != nil {
+		return Error(StatusUnexpectedError, nil, err).JSON(w)
+	}
+
+	if lm, ok := resp.(*lastModifiedResponse); ok && !lm.lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lm.lastModified.UTC().Format(http.TimeFormat))
+	}
+	w.Header().Set("Content-Type", renderer.ContentType()+"; charset=utf-8")
+	w.WriteHeader(impl.getStatusCode(impl.Status))
+	_, err = w.Write(body.Bytes())
+
+	return
+}
+
+// choose returns the renderer with the highest quality in the Accept header, nil stands for JSON.
+// It reports false when the client accepts none of the formats.
+func (n *Negotiator) choose(accept string) (renderer Renderer, acceptable bool) {
+	if strings.TrimSpace(accept) == "" {
+		return nil, true
+	}
+
+	type candidate struct {
+		mediaType string
+		quality   float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, candidate{mediaType, quality})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	for _, c := range candidates {
+		switch c.mediaType {
+		case "application/json", "application/*", "*/*":
+			return nil, true
+		}
+
+		for _, renderer := range n.renderers {
+			if matchMediaType(c.mediaType, renderer.ContentType()) {
+				return renderer, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func matchMediaType(pattern, mediaType string) bool {
+	if pattern == mediaType {
+		return true
+	}
+
+	if strings.HasSuffix(pattern, "/*") {
+		return strings.HasPrefix(mediaType, strings.TrimSuffix(pattern, "*"))
+	}
+
+	return false
+}
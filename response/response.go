@@ -42,6 +42,10 @@ func (r *responseImpl) getStatusCode(status string) (statusCode int) {
 		return http.StatusConflict
 	case StatusForbiddend:
 		return http.StatusForbidden
+	case StatusNotFound:
+		return http.StatusNotFound
+	case StatusUnauthorized:
+		return http.StatusUnauthorized
 	case StatusUnprocessabelEntity:
 		return http.StatusUnprocessableEntity
 	case StatusInvalidPayload:
@@ -27,6 +27,27 @@ func (_m *Session) Delete(ctx context.Context, key string) error {
 	return r0
 }
 
+// DeleteFields provides a mock function with given fields: ctx, key, fields
+func (_m *Session) DeleteFields(ctx context.Context, key string, fields ...string) error {
+	_va := make([]interface{}, len(fields))
+	for _i := range fields {
+		_va[_i] = fields[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) error); ok {
+		r0 = rf(ctx, key, fields...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, key
 func (_m *Session) Get(ctx context.Context, key string) ([]byte, error) {
 	ret := _m.Called(ctx, key)
@@ -50,6 +71,52 @@ func (_m *Session) Get(ctx context.Context, key string) ([]byte, error) {
 	return r0, r1
 }
 
+// GetField provides a mock function with given fields: ctx, key, field
+func (_m *Session) GetField(ctx context.Context, key string, field string) ([]byte, error) {
+	ret := _m.Called(ctx, key, field)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, key, field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFields provides a mock function with given fields: ctx, key
+func (_m *Session) GetFields(ctx context.Context, key string) (map[string][]byte, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 map[string][]byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string][]byte); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Set provides a mock function with given fields: ctx, key, value
 func (_m *Session) Set(ctx context.Context, key string, value []byte) error {
 	ret := _m.Called(ctx, key, value)
@@ -64,6 +131,20 @@ func (_m *Session) Set(ctx context.Context, key string, value []byte) error {
 	return r0
 }
 
+// SetField provides a mock function with given fields: ctx, key, field, value
+func (_m *Session) SetField(ctx context.Context, key string, field string, value []byte) error {
+	ret := _m.Called(ctx, key, field, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, key, field, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, key, value
 func (_m *Session) Update(ctx context.Context, key string, value []byte) error {
 	ret := _m.Called(ctx, key, value)
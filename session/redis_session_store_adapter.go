@@ -95,11 +95,14 @@ func (s RedisSessionStoreAdapter) Delete(ctx context.Context, key string) (err e
 	watchTxID := fmt.Sprintf("watch:transaction:session:%s", key)
 
 	err = s.c.Watch(ctx, func(tx *rv8.Tx) (err error) {
-		_, err = tx.Get(ctx, key).Result()
+		exists, err := tx.Exists(ctx, key).Result()
 		if err != nil {
 			s.logger.Error(err)
 			return ErrUnexpected
 		}
+		if exists == 0 {
+			return ErrSessionNotFound
+		}
 
 		_, err = tx.TxPipelined(ctx, func(pipe rv8.Pipeliner) (err error) {
 			_, err = pipe.Del(ctx, key).Result()
@@ -116,3 +119,76 @@ func (s RedisSessionStoreAdapter) Delete(ctx context.Context, key string) (err e
 
 	return
 }
+
+// SetField will store the value under the field of a session kept as hash, the whole session lives for max age from now.
+func (s RedisSessionStoreAdapter) SetField(ctx context.Context, key string, field string, value []byte) (err error) {
+	span, ctx := apm.StartSpan(ctx, "Redis Session Store: SetField", "cache.session")
+	defer span.End()
+
+	_, err = s.c.TxPipelined(ctx, func(pipe rv8.Pipeliner) (err error) {
+		pipe.HSet(ctx, key, field, value)
+		pipe.Expire(ctx, key, s.maxAge)
+		return
+	})
+	if err != nil {
+		s.logger.Error(err)
+		return ErrUnexpected
+	}
+
+	return
+}
+
+// GetField will get the value of a field of the session.
+func (s RedisSessionStoreAdapter) GetField(ctx context.Context, key string, field string) (value []byte, err error) {
+	span, ctx := apm.StartSpan(ctx, "Redis Session Store: GetField", "cache.session")
+	defer span.End()
+
+	value, err = s.c.HGet(ctx, key, field).Bytes()
+	if err != nil {
+		if err == rv8.Nil {
+			return value, ErrSessionNotFound
+		}
+
+		return value, ErrUnexpected
+	}
+
+	return
+}
+
+// GetFields will get every field of the session, none when there is no session.
+func (s RedisSessionStoreAdapter) GetFields(ctx context.Context, key string) (values map[string][]byte, err error) {
+	span, ctx := apm.StartSpan(ctx, "Redis Session Store: GetFields", "cache.session")
+	defer span.End()
+
+	result, err := s.c.HGetAll(ctx, key).Result()
+	if err != nil {
+		s.logger.Error(err)
+		return nil, ErrUnexpected
+	}
+
+	values = make(map[string][]byte, len(result))
+	for field, value := range result {
+		values[field] = []byte(value)
+	}
+
+	return
+}
+
+// DeleteFields will delete the fields of the session but never change the time to live.
+// The session is gone once its last field is deleted.
+func (s RedisSessionStoreAdapter) DeleteFields(ctx context.Context, key string, fields ...string) (err error) {
+	span, ctx := apm.StartSpan(ctx, "Redis Session Store: DeleteFields", "cache.session")
+	defer span.End()
+
+	if len(fields) == 0 {
+		return
+	}
+
+	_, err = s.c.HDel(ctx, key, fields...).Result()
+	if err != nil {
+		s.logger.Error(err)
+		return ErrUnexpected
+	}
+
+	return
+}
@@ -110,3 +110,64 @@ func TestRedisSessionAdapter_Update_Success(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestRedisSessionAdapter_SetField_Success(t *testing.T) {
+	value := []byte("testvalue")
+	rdb, mock := redismock.NewClientMock()
+	mock.ExpectTxPipeline()
+	mock.ExpectHSet("testhash", "field", value).SetVal(1)
+	mock.ExpectExpire("testhash", time.Second*5).SetVal(true)
+	mock.ExpectTxPipelineExec()
+
+	sess := session.NewRedisSessionStoreAdapter(rdb, time.Second*5)
+	err := sess.SetField(context.TODO(), "testhash", "field", value)
+
+	assert.NoError(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRedisSessionAdapter_GetField_ErrorSessionNotFound(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.ExpectHGet("testhash", "field").RedisNil()
+
+	sess := session.NewRedisSessionStoreAdapter(rdb, time.Second*5)
+	_, err := sess.GetField(context.TODO(), "testhash", "field")
+
+	assert.Equal(t, session.ErrSessionNotFound, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRedisSessionAdapter_DeleteFields_Success(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.ExpectHDel("testhash", "field", "other").SetVal(2)
+
+	sess := session.NewRedisSessionStoreAdapter(rdb, time.Second*5)
+	err := sess.DeleteFields(context.TODO(), "testhash", "field", "other")
+
+	assert.NoError(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRedisSessionAdapter_Delete_ErrorSessionNotFound(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.ExpectWatch("watch:transaction:session:testhash")
+	mock.ExpectExists("testhash").SetVal(0)
+
+	sess := session.NewRedisSessionStoreAdapter(rdb, time.Second*5)
+	err := sess.Delete(context.TODO(), "testhash")
+
+	assert.Equal(t, session.ErrSessionNotFound, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -17,4 +17,8 @@ type Session interface {
 	Get(ctx context.Context, key string) (value []byte, err error)
 	Update(ctx context.Context, key string, value []byte) (err error)
 	Delete(ctx context.Context, key string) (err error)
+	SetField(ctx context.Context, key string, field string, value []byte) (err error)
+	GetField(ctx context.Context, key string, field string) (value []byte, err error)
+	GetFields(ctx context.Context, key string) (values map[string][]byte, err error)
+	DeleteFields(ctx context.Context, key string, fields ...string) (err error)
 }